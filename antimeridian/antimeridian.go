@@ -0,0 +1,232 @@
+// Package antimeridian splits lon/lat geometries that cross the antimeridian, 180° longitude,
+// into parts on either side of it as recommended by RFC 7946 section 3.1.9,
+// so that the bound, clipping and tile cover of each part are correct.
+package antimeridian
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+// Geometry splits the lines and areas of the geometry at the antimeridian. Lines and
+// polygons keep their type if they do not cross it, otherwise they become a multi line
+// string or a multi polygon. Longitudes are normalized to [-180, 180], bounds are
+// returned unchanged. The input is not modified.
+func Geometry(g geo.Geometry) geo.Geometry {
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case geo.Point:
+		return normalize(g)
+	case geo.MultiPoint:
+		mp := make(geo.MultiPoint, 0, len(g))
+		for _, p := range g {
+			mp = append(mp, normalize(p))
+		}
+
+		return mp
+	case geo.LineString:
+		if mls := LineString(g); len(mls) == 1 {
+			return mls[0]
+		} else {
+			return mls
+		}
+	case geo.MultiLineString:
+		return MultiLineString(g)
+	case geo.Ring:
+		return Geometry(geo.Polygon{g})
+	case geo.Polygon:
+		if mp := Polygon(g); len(mp) == 1 {
+			return mp[0]
+		} else {
+			return mp
+		}
+	case geo.MultiPolygon:
+		return MultiPolygon(g)
+	case geo.Collection:
+		c := make(geo.Collection, 0, len(g))
+		for _, sub := range g {
+			c = append(c, Geometry(sub))
+		}
+
+		return c
+	case geo.Bound:
+		return g
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+// LineString splits the line string where a segment crosses the antimeridian,
+// i.e. where the shorter way between two points goes across it.
+// The crossing latitude is interpolated linearly in lon/lat, densify the line
+// with geometries.Densify first to follow great circles.
+func LineString(ls geo.LineString) geo.MultiLineString {
+	if len(ls) == 0 {
+		return nil
+	}
+
+	var result geo.MultiLineString
+	current := geo.LineString{normalize(ls[0])}
+	for i := 1; i < len(ls); i++ {
+		a, b := current[len(current)-1], normalize(ls[i])
+		if math.Abs(a[0]) == 180 && math.Abs(b[0]) == 180 {
+			// along the antimeridian
+			b[0] = a[0]
+		}
+
+		if d := b[0] - a[0]; d > 180 || d < -180 {
+			side := math.Copysign(180, a[0])
+			lat := crossingLatitude(a, b, side)
+			if end := (geo.Point{side, lat}); end != a {
+				current = append(current, end)
+			}
+
+			if len(current) > 1 {
+				result = append(result, current)
+			}
+
+			current = geo.LineString{{-side, lat}}
+		}
+
+		if b != current[len(current)-1] {
+			current = append(current, b)
+		}
+	}
+
+	if len(current) > 1 || len(result) == 0 {
+		result = append(result, current)
+	}
+
+	return result
+}
+
+// MultiLineString splits each line string of the multi line string at the antimeridian.
+func MultiLineString(mls geo.MultiLineString) geo.MultiLineString {
+	var result geo.MultiLineString
+	for _, ls := range mls {
+		result = append(result, LineString(ls)...)
+	}
+
+	return result
+}
+
+// Polygon splits the polygon into the parts on either side of the antimeridian.
+// The rings are followed the shorter way between their points, and a shell that
+// goes around a pole is closed over it, the north pole if the shell goes east.
+func Polygon(p geo.Polygon) geo.MultiPolygon {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return nil
+	}
+
+	shell := unwrap(p[0], normalize(p[0][0])[0])
+	turn := shell[len(shell)-1][0] - shell[0][0]
+	if math.Abs(turn) > 180 {
+		pole := math.Copysign(90, turn)
+		shell = append(shell,
+			geo.Point{shell[len(shell)-1][0], pole},
+			geo.Point{shell[0][0], pole},
+			shell[0],
+		)
+	}
+
+	unwrapped := geo.Polygon{shell}
+	b := shell.Bound()
+	center := (b.Min[0] + b.Max[0]) / 2
+	for _, r := range p[1:] {
+		if len(r) > 0 {
+			// start the hole on the same turn of the earth as the shell
+			start := r[0][0] + 360*math.Round((center-r[0][0])/360)
+			unwrapped = append(unwrapped, unwrap(r, start))
+		}
+	}
+
+	if b.Min[0] >= -180 && b.Max[0] <= 180 {
+		return geo.MultiPolygon{unwrapped}
+	}
+
+	var result geo.MultiPolygon
+	for k := math.Floor((b.Min[0] + 180) / 360); -180+360*k < b.Max[0]; k++ {
+		window := geo.Bound{Min: geo.Point{-180 + 360*k, -90}, Max: geo.Point{180 + 360*k, 90}}
+		for _, part := range planar.Intersection(unwrapped, window) {
+			for _, r := range part {
+				for i := range r {
+					r[i] = snap(geo.Point{r[i][0] - 360*k, r[i][1]})
+				}
+			}
+
+			result = append(result, part)
+		}
+	}
+
+	if math.Abs(turn) > 180 {
+		// the parts around the pole meet at the longitude of the start
+		result = planar.Union(result, geo.MultiPolygon{})
+	}
+
+	return result
+}
+
+// MultiPolygon splits each polygon of the multi polygon at the antimeridian.
+func MultiPolygon(mp geo.MultiPolygon) geo.MultiPolygon {
+	var result geo.MultiPolygon
+	for _, p := range mp {
+		result = append(result, Polygon(p)...)
+	}
+
+	return result
+}
+
+// unwrap returns a copy of the ring with the longitudes shifted by multiples
+// of 360 so that consecutive points are less than 180 degrees apart.
+// The first point is at the start longitude.
+func unwrap(r geo.Ring, start float64) geo.Ring {
+	result := make(geo.Ring, 0, len(r))
+	prev := start
+	for i, p := range r {
+		lon := p[0]
+		if i == 0 {
+			lon = start
+		} else {
+			lon += 360 * math.Round((prev-lon)/360)
+		}
+
+		result = append(result, geo.Point{lon, p[1]})
+		prev = lon
+	}
+
+	return result
+}
+
+// crossingLatitude returns the latitude where the segment
+// crosses the antimeridian on the side of a.
+func crossingLatitude(a, b geo.Point, side float64) float64 {
+	bx := b[0] + 2*side
+	if a[0] == bx {
+		return a[1]
+	}
+
+	return a[1] + (side-a[0])/(bx-a[0])*(b[1]-a[1])
+}
+
+// normalize returns the point with the longitude in [-180, 180],
+// 180 and -180 are kept.
+func normalize(p geo.Point) geo.Point {
+	if p[0] >= -180 && p[0] <= 180 {
+		return p
+	}
+
+	return geo.Point{geo.WrapLon(p[0]), p[1]}
+}
+
+// snap puts longitudes that are within rounding errors of the antimeridian on it.
+func snap(p geo.Point) geo.Point {
+	if math.Abs(math.Abs(p[0])-180) < 1e-9 {
+		p[0] = math.Copysign(180, p[0])
+	}
+
+	return p
+}
@@ -0,0 +1,183 @@
+package antimeridian
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestLineString(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  geo.LineString
+		result geo.MultiLineString
+	}{
+		{
+			name:   "no crossing",
+			input:  geo.LineString{{-170, 0}, {-10, 10}},
+			result: geo.MultiLineString{{{-170, 0}, {-10, 10}}},
+		},
+		{
+			name:  "east",
+			input: geo.LineString{{160, 0}, {170, 10}, {-170, 20}, {-160, 20}},
+			result: geo.MultiLineString{
+				{{160, 0}, {170, 10}, {180, 15}},
+				{{-180, 15}, {-170, 20}, {-160, 20}},
+			},
+		},
+		{
+			name:  "west and back",
+			input: geo.LineString{{-170, 0}, {170, 0}, {-170, 10}},
+			result: geo.MultiLineString{
+				{{-170, 0}, {-180, 0}},
+				{{180, 0}, {170, 0}, {180, 5}},
+				{{-180, 5}, {-170, 10}},
+			},
+		},
+		{
+			name:  "longitudes beyond 180",
+			input: geo.LineString{{170, 0}, {190, 10}},
+			result: geo.MultiLineString{
+				{{170, 0}, {180, 5}},
+				{{-180, 5}, {-170, 10}},
+			},
+		},
+		{
+			name:  "starts on the antimeridian",
+			input: geo.LineString{{180, 0}, {-170, 0}},
+			result: geo.MultiLineString{
+				{{-180, 0}, {-170, 0}},
+			},
+		},
+		{
+			name:  "along the antimeridian",
+			input: geo.LineString{{180, 0}, {-180, 10}, {170, 10}},
+			result: geo.MultiLineString{
+				{{180, 0}, {180, 10}, {170, 10}},
+			},
+		},
+		{
+			name:   "single point",
+			input:  geo.LineString{{200, 0}},
+			result: geo.MultiLineString{{{-160, 0}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if mls := LineString(tc.input); !reflect.DeepEqual(mls, tc.result) {
+				t.Errorf("incorrect lines: %v", mls)
+			}
+		})
+	}
+}
+
+func TestPolygon(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  geo.Polygon
+		bounds []geo.Bound
+		area   float64
+	}{
+		{
+			name:   "no crossing",
+			input:  geo.Polygon{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			bounds: []geo.Bound{{Min: geo.Point{10, 0}, Max: geo.Point{20, 10}}},
+			area:   100,
+		},
+		{
+			name:  "crossing",
+			input: geo.Polygon{{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}}},
+			bounds: []geo.Bound{
+				{Min: geo.Point{170, 0}, Max: geo.Point{180, 10}},
+				{Min: geo.Point{-180, 0}, Max: geo.Point{-170, 10}},
+			},
+			area: 200,
+		},
+		{
+			name: "hole across the antimeridian",
+			input: geo.Polygon{
+				{{160, 0}, {-160, 0}, {-160, 20}, {160, 20}, {160, 0}},
+				{{175, 5}, {175, 15}, {-175, 15}, {-175, 5}, {175, 5}},
+			},
+			bounds: []geo.Bound{
+				{Min: geo.Point{160, 0}, Max: geo.Point{180, 20}},
+				{Min: geo.Point{-180, 0}, Max: geo.Point{-160, 20}},
+			},
+			area: 800 - 100,
+		},
+		{
+			name:  "around the north pole",
+			input: geo.Polygon{{{0, 80}, {90, 80}, {180, 80}, {-90, 80}, {0, 80}}},
+			bounds: []geo.Bound{
+				{Min: geo.Point{-180, 80}, Max: geo.Point{180, 90}},
+			},
+			area: 3600,
+		},
+		{
+			name:  "around the south pole",
+			input: geo.Polygon{{{0, -80}, {-90, -80}, {180, -80}, {90, -80}, {0, -80}}},
+			bounds: []geo.Bound{
+				{Min: geo.Point{-180, -90}, Max: geo.Point{180, -80}},
+			},
+			area: 3600,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := Polygon(tc.input)
+			if len(mp) != len(tc.bounds) {
+				t.Fatalf("incorrect number of polygons: %v", mp)
+			}
+
+			for _, b := range tc.bounds {
+				found := false
+				for _, p := range mp {
+					found = found || p.Bound() == b
+				}
+
+				if !found {
+					t.Errorf("missing polygon with bound %v: %v", b, mp)
+				}
+			}
+
+			if a := planar.Area(mp); math.Abs(a-tc.area) > 1e-9 {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+		})
+	}
+}
+
+func TestGeometry(t *testing.T) {
+	crossing := geo.LineString{{170, 0}, {-170, 0}}
+	if _, ok := Geometry(crossing).(geo.MultiLineString); !ok {
+		t.Errorf("should be a multi line string")
+	}
+
+	if g := Geometry(geo.LineString{{10, 0}, {20, 0}}); !reflect.DeepEqual(g, geo.LineString{{10, 0}, {20, 0}}) {
+		t.Errorf("incorrect line string: %v", g)
+	}
+
+	if g := Geometry(geo.Point{190, 1}); g != (geo.Point{-170, 1}) {
+		t.Errorf("incorrect point: %v", g)
+	}
+
+	if _, ok := Geometry(geo.Ring{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}}).(geo.MultiPolygon); !ok {
+		t.Errorf("should be a multi polygon")
+	}
+
+	// the input is not modified
+	if !reflect.DeepEqual(crossing, geo.LineString{{170, 0}, {-170, 0}}) {
+		t.Errorf("input was modified: %v", crossing)
+	}
+}
+
+func TestGeometry_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Geometry(g)
+	}
+}
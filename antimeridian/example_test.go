@@ -0,0 +1,35 @@
+package antimeridian_test
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/antimeridian"
+	"github.com/pchchv/geo/geometries"
+)
+
+func ExampleGeometry() {
+	// Fiji spans the antimeridian
+	box := geo.Polygon{{{177, -19}, {-179, -19}, {-179, -16}, {177, -16}, {177, -19}}}
+	mp := antimeridian.Geometry(box).(geo.MultiPolygon)
+
+	for _, p := range mp {
+		fmt.Println(p.Bound())
+	}
+	// Output:
+	// {[177 -19] [180 -16]}
+	// {[-180 -19] [-179 -16]}
+}
+
+func ExampleLineString() {
+	// a flight from Tokyo to San Francisco along the great circle
+	flight := geo.LineString{{139.78, 35.55}, {-122.38, 37.62}}
+	path := geometries.Densify(flight, 500000).(geo.LineString)
+
+	parts := antimeridian.LineString(path)
+	fmt.Println(len(parts))
+	fmt.Printf("%.2f %.2f\n", parts[0][len(parts[0])-1], parts[1][0])
+	// Output:
+	// 2
+	// [180.00 47.98] [-180.00 47.98]
+}
@@ -0,0 +1,267 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// BoundWrapped is a lon/lat box that may cross the antimeridian.
+// Min is the south west and Max the north east corner, if Min[0] > Max[0]
+// the box crosses the antimeridian and covers the longitudes from Min[0]
+// to 180 and from -180 to Max[0], like the GeoJSON bbox.
+type BoundWrapped struct {
+	Min Point
+	Max Point
+}
+
+// NewBoundWrapped returns the smallest bound around the lon/lat geometry,
+// crossing the antimeridian if that is narrower. Segments are taken to go
+// the shorter way around, the longitudes of a geo.Bound are used as is.
+// Returns an empty bound for empty geometries.
+func NewBoundWrapped(g Geometry) BoundWrapped {
+	w := newLonArcs()
+	w.add(g)
+	return w.bound()
+}
+
+// Bound returns the geo.Bound of the box, from -180 to 180 if it crosses the antimeridian.
+func (b BoundWrapped) Bound() Bound {
+	if b.Crosses() && !b.IsEmpty() {
+		return Bound{Min: Point{-180, b.Min[1]}, Max: Point{180, b.Max[1]}}
+	}
+
+	return Bound{Min: b.Min, Max: b.Max}
+}
+
+// Crosses returns true if the box crosses the antimeridian.
+func (b BoundWrapped) Crosses() bool {
+	return b.Min[0] > b.Max[0]
+}
+
+// IsEmpty returns true if the south is north of the north.
+func (b BoundWrapped) IsEmpty() bool {
+	return b.Min[1] > b.Max[1]
+}
+
+// Width returns the width of the box in degrees of longitude.
+func (b BoundWrapped) Width() float64 {
+	if b.Crosses() {
+		return b.Max[0] - b.Min[0] + 360
+	}
+
+	return b.Max[0] - b.Min[0]
+}
+
+// Center returns the center of the box, with the longitude within [-180, 180).
+func (b BoundWrapped) Center() Point {
+	return Point{
+		WrapLon(b.Min[0] + b.Width()/2),
+		(b.Min[1] + b.Max[1]) / 2,
+	}
+}
+
+// Bounds returns the box split at the antimeridian, one bound on each side
+// if it crosses it, the box as a geo.Bound otherwise.
+func (b BoundWrapped) Bounds() []Bound {
+	if b.IsEmpty() {
+		return nil
+	}
+
+	if !b.Crosses() {
+		return []Bound{{Min: b.Min, Max: b.Max}}
+	}
+
+	return []Bound{
+		{Min: b.Min, Max: Point{180, b.Max[1]}},
+		{Min: Point{-180, b.Min[1]}, Max: b.Max},
+	}
+}
+
+// Contains determines if the point is within the box, the longitude is normalized first.
+// Points on the boundary are considered within.
+func (b BoundWrapped) Contains(point Point) bool {
+	if point[1] < b.Min[1] || b.Max[1] < point[1] {
+		return false
+	}
+
+	return lonArc{WrapLon(b.Min[0]), b.Width()}.contains(point[0])
+}
+
+// Intersects determines if the two boxes intersect.
+// Returns true if they are touching.
+func (b BoundWrapped) Intersects(other BoundWrapped) bool {
+	if b.IsEmpty() || other.IsEmpty() ||
+		b.Max[1] < other.Min[1] || b.Min[1] > other.Max[1] {
+		return false
+	}
+
+	a1 := lonArc{WrapLon(b.Min[0]), b.Width()}
+	a2 := lonArc{WrapLon(other.Min[0]), other.Width()}
+	return a1.contains(a2.start) || a2.contains(a1.start)
+}
+
+// Extend grows the box to include the point, towards the side that keeps it narrower.
+func (b BoundWrapped) Extend(point Point) BoundWrapped {
+	if b.Contains(point) {
+		return b
+	}
+
+	w := newLonArcs()
+	w.addBound(b)
+	w.addLine([]Point{point})
+	return w.bound()
+}
+
+// Union returns the smallest box that contains both boxes.
+func (b BoundWrapped) Union(other BoundWrapped) BoundWrapped {
+	w := newLonArcs()
+	w.addBound(b)
+	w.addBound(other)
+	return w.bound()
+}
+
+// lonArc is the range of longitudes from start going east for width degrees.
+type lonArc struct {
+	start, width float64
+}
+
+func (a lonArc) contains(lon float64) bool {
+	d := math.Mod(lon-a.start, 360)
+	if d < 0 {
+		d += 360
+	}
+
+	return d <= a.width
+}
+
+// lonArcs collects the longitude ranges and the latitudes covered by geometries.
+type lonArcs struct {
+	arcs         []lonArc
+	south, north float64
+}
+
+func newLonArcs() *lonArcs {
+	return &lonArcs{south: math.Inf(1), north: math.Inf(-1)}
+}
+
+func (w *lonArcs) add(g Geometry) {
+	switch g := g.(type) {
+	case nil:
+	case Point:
+		w.addLine([]Point{g})
+	case MultiPoint:
+		for _, p := range g {
+			w.addLine([]Point{p})
+		}
+	case LineString:
+		w.addLine(g)
+	case MultiLineString:
+		for _, ls := range g {
+			w.addLine(ls)
+		}
+	case Ring:
+		w.addLine(g)
+	case Polygon:
+		for _, r := range g {
+			w.addLine(r)
+		}
+	case MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				w.addLine(r)
+			}
+		}
+	case Collection:
+		for _, c := range g {
+			w.add(c)
+		}
+	case Bound:
+		if !g.IsEmpty() {
+			w.addBound(BoundWrapped{Min: g.Min, Max: g.Max})
+		}
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (w *lonArcs) addLatitude(lat float64) {
+	w.south = math.Min(w.south, lat)
+	w.north = math.Max(w.north, lat)
+}
+
+// addLine adds the points and the shorter arc between consecutive points.
+func (w *lonArcs) addLine(ls []Point) {
+	for i, p := range ls {
+		w.addLatitude(p[1])
+		if i == 0 {
+			w.arcs = append(w.arcs, lonArc{WrapLon(p[0]), 0})
+			continue
+		}
+
+		if d := WrapLon(p[0] - ls[i-1][0]); d >= 0 {
+			w.arcs = append(w.arcs, lonArc{WrapLon(ls[i-1][0]), d})
+		} else {
+			w.arcs = append(w.arcs, lonArc{WrapLon(p[0]), -d})
+		}
+	}
+}
+
+func (w *lonArcs) addBound(b BoundWrapped) {
+	if b.IsEmpty() {
+		return
+	}
+
+	w.addLatitude(b.Min[1])
+	w.addLatitude(b.Max[1])
+	w.arcs = append(w.arcs, lonArc{WrapLon(b.Min[0]), b.Width()})
+}
+
+func (w *lonArcs) bound() BoundWrapped {
+	if len(w.arcs) == 0 {
+		return BoundWrapped{Min: emptyBound.Min, Max: emptyBound.Max}
+	}
+
+	west, east := coverArcs(w.arcs)
+	return BoundWrapped{
+		Min: Point{west, w.south},
+		Max: Point{east, w.north},
+	}
+}
+
+// coverArcs returns the west and east of the smallest arc that covers all the arcs,
+// that is the complement of the largest gap between them.
+func coverArcs(arcs []lonArc) (west, east float64) {
+	sort.Slice(arcs, func(i, j int) bool { return arcs[i].start < arcs[j].start })
+
+	// the ends may be past 180, the arcs that wrap around cover up to wrapped
+	end := math.Inf(-1)
+	for _, a := range arcs {
+		end = math.Max(end, a.start+a.width)
+	}
+
+	wrapped := end - 360
+	gapStart, gap := end, arcs[0].start+360-end
+	covered := math.Inf(-1)
+	for i, a := range arcs {
+		if i > 0 {
+			if s := math.Max(covered, wrapped); a.start-s > gap {
+				gapStart, gap = s, a.start-s
+			}
+		}
+
+		covered = math.Max(covered, a.start+a.width)
+	}
+
+	if gap <= 0 {
+		return -180, 180
+	}
+
+	west = WrapLon(gapStart + gap)
+	east = WrapLon(gapStart)
+	if east == -180 && west != -180 {
+		east = 180
+	}
+
+	return west, east
+}
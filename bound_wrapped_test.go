@@ -0,0 +1,228 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewBoundWrapped(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   Geometry
+		result BoundWrapped
+	}{
+		{
+			name:   "point",
+			geom:   Point{10, 20},
+			result: BoundWrapped{Min: Point{10, 20}, Max: Point{10, 20}},
+		},
+		{
+			name:   "line not crossing",
+			geom:   LineString{{-10, 0}, {10, 5}},
+			result: BoundWrapped{Min: Point{-10, 0}, Max: Point{10, 5}},
+		},
+		{
+			name:   "line crossing",
+			geom:   LineString{{170, -20}, {-175, -10}},
+			result: BoundWrapped{Min: Point{170, -20}, Max: Point{-175, -10}},
+		},
+		{
+			name:   "points on both sides",
+			geom:   MultiPoint{{178, -18}, {-179, -16}, {179, -17}},
+			result: BoundWrapped{Min: Point{178, -18}, Max: Point{-179, -16}},
+		},
+		{
+			name:   "polygon crossing",
+			geom:   Polygon{{{175, 50}, {-170, 50}, {-170, 55}, {175, 55}, {175, 50}}},
+			result: BoundWrapped{Min: Point{175, 50}, Max: Point{-170, 55}},
+		},
+		{
+			name:   "line to 180",
+			geom:   LineString{{170, 0}, {180, 1}},
+			result: BoundWrapped{Min: Point{170, 0}, Max: Point{180, 1}},
+		},
+		{
+			name:   "line from -180",
+			geom:   LineString{{-180, 0}, {-170, 1}},
+			result: BoundWrapped{Min: Point{-180, 0}, Max: Point{-170, 1}},
+		},
+		{
+			name:   "bound is used as is",
+			geom:   Bound{Min: Point{-170, 0}, Max: Point{170, 1}},
+			result: BoundWrapped{Min: Point{-170, 0}, Max: Point{170, 1}},
+		},
+		{
+			name:   "around the world",
+			geom:   LineString{{0, 0}, {120, 0}, {-120, 0}, {0, 0}},
+			result: BoundWrapped{Min: Point{-180, 0}, Max: Point{180, 0}},
+		},
+		{
+			name: "collection",
+			geom: Collection{
+				Point{-179, 1},
+				LineString{{160, 0}, {170, 2}},
+			},
+			result: BoundWrapped{Min: Point{160, 0}, Max: Point{-179, 2}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if b := NewBoundWrapped(tc.geom); b != tc.result {
+				t.Errorf("incorrect bound: %v != %v", b, tc.result)
+			}
+		})
+	}
+}
+
+func TestNewBoundWrapped_empty(t *testing.T) {
+	if b := NewBoundWrapped(MultiPoint{}); !b.IsEmpty() {
+		t.Errorf("should be empty: %v", b)
+	}
+}
+
+func TestNewBoundWrapped_allGeometries(t *testing.T) {
+	for _, g := range AllGeometries {
+		NewBoundWrapped(g)
+	}
+}
+
+func TestBoundWrapped(t *testing.T) {
+	fiji := BoundWrapped{Min: Point{177, -21}, Max: Point{-178, -12}}
+
+	if !fiji.Crosses() {
+		t.Errorf("should cross")
+	}
+
+	if w := fiji.Width(); w != 5 {
+		t.Errorf("incorrect width: %v", w)
+	}
+
+	if c := fiji.Center(); c != (Point{179.5, -16.5}) {
+		t.Errorf("incorrect center: %v", c)
+	}
+
+	if b := fiji.Bound(); b != (Bound{Min: Point{-180, -21}, Max: Point{180, -12}}) {
+		t.Errorf("incorrect bound: %v", b)
+	}
+
+	expected := []Bound{
+		{Min: Point{177, -21}, Max: Point{180, -12}},
+		{Min: Point{-180, -21}, Max: Point{-178, -12}},
+	}
+	if bs := fiji.Bounds(); !reflect.DeepEqual(bs, expected) {
+		t.Errorf("incorrect bounds: %v", bs)
+	}
+}
+
+func TestBoundWrappedContains(t *testing.T) {
+	fiji := BoundWrapped{Min: Point{177, -21}, Max: Point{-178, -12}}
+	cases := []struct {
+		name   string
+		point  Point
+		result bool
+	}{
+		{name: "west side", point: Point{178, -18}, result: true},
+		{name: "east side", point: Point{-179, -18}, result: true},
+		{name: "antimeridian", point: Point{180, -18}, result: true},
+		{name: "west edge", point: Point{177, -12}, result: true},
+		{name: "east edge", point: Point{-178, -21}, result: true},
+		{name: "not normalized", point: Point{181, -18}, result: true},
+		{name: "greenwich", point: Point{0, -18}, result: false},
+		{name: "west of the box", point: Point{176, -18}, result: false},
+		{name: "north of the box", point: Point{179, 0}, result: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := fiji.Contains(tc.point); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestBoundWrappedIntersects(t *testing.T) {
+	fiji := BoundWrapped{Min: Point{177, -21}, Max: Point{-178, -12}}
+	cases := []struct {
+		name   string
+		bound  BoundWrapped
+		result bool
+	}{
+		{
+			name:   "west side",
+			bound:  BoundWrapped{Min: Point{170, -20}, Max: Point{178, -10}},
+			result: true,
+		},
+		{
+			name:   "east side",
+			bound:  BoundWrapped{Min: Point{-179, -20}, Max: Point{-170, -10}},
+			result: true,
+		},
+		{
+			name:   "both crossing",
+			bound:  BoundWrapped{Min: Point{179, -30}, Max: Point{-179, 0}},
+			result: true,
+		},
+		{
+			name:   "touching",
+			bound:  BoundWrapped{Min: Point{-178, -20}, Max: Point{-170, -10}},
+			result: true,
+		},
+		{
+			name:   "far away",
+			bound:  BoundWrapped{Min: Point{-10, -20}, Max: Point{10, -10}},
+			result: false,
+		},
+		{
+			name:   "other latitudes",
+			bound:  BoundWrapped{Min: Point{179, 10}, Max: Point{-179, 20}},
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := fiji.Intersects(tc.bound); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+
+			if v := tc.bound.Intersects(fiji); v != tc.result {
+				t.Errorf("incorrect reverse result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestBoundWrappedUnion(t *testing.T) {
+	a := BoundWrapped{Min: Point{170, 0}, Max: Point{175, 1}}
+	b := BoundWrapped{Min: Point{-175, 2}, Max: Point{-170, 3}}
+	expected := BoundWrapped{Min: Point{170, 0}, Max: Point{-170, 3}}
+	if u := a.Union(b); u != expected {
+		t.Errorf("incorrect union: %v", u)
+	}
+
+	if u := b.Union(a); u != expected {
+		t.Errorf("incorrect reverse union: %v", u)
+	}
+
+	c := BoundWrapped{Min: Point{-10, 0}, Max: Point{10, 1}}
+	if u := c.Union(BoundWrapped{Min: Point{20, 0}, Max: Point{30, 1}}); u != (BoundWrapped{Min: Point{-10, 0}, Max: Point{30, 1}}) {
+		t.Errorf("incorrect union: %v", u)
+	}
+}
+
+func TestBoundWrappedExtend(t *testing.T) {
+	b := BoundWrapped{Min: Point{170, 0}, Max: Point{175, 1}}
+	if e := b.Extend(Point{-178, 2}); e != (BoundWrapped{Min: Point{170, 0}, Max: Point{-178, 2}}) {
+		t.Errorf("should extend east across the antimeridian: %v", e)
+	}
+
+	if e := b.Extend(Point{160, 0}); e != (BoundWrapped{Min: Point{160, 0}, Max: Point{175, 1}}) {
+		t.Errorf("should extend west: %v", e)
+	}
+
+	if e := b.Extend(Point{172, 0.5}); e != b {
+		t.Errorf("should not change: %v", e)
+	}
+}
@@ -23,12 +23,14 @@ var (
 	ErrNotEWKB                              = errors.New("wkb: invalid data")              // returned when unmarshalling EWKB and the data is not valid.
 	ErrIncorrectGeometry                    = errors.New("wkb: incorrect geometry")        // returned when unmarshalling EWKB data into the wrong type.
 	ErrUnsupportedGeometry                  = errors.New("wkb: unsupported geometry")      // returned when geometry type is not supported by this lib.
+	ErrIncorrectZM                          = errors.New("wkb: incorrect z and m values")  // returned when marshalling with z and m values that do not match the points of the geometry.
 	commonErrorMap                          = map[error]error{
 		wkbcommon.ErrUnsupportedDataType: ErrUnsupportedDataType,
 		wkbcommon.ErrNotWKB:              ErrNotEWKB,
 		wkbcommon.ErrNotWKBHeader:        ErrNotEWKB,
 		wkbcommon.ErrIncorrectGeometry:   ErrIncorrectGeometry,
 		wkbcommon.ErrUnsupportedGeometry: ErrUnsupportedGeometry,
+		wkbcommon.ErrIncorrectZM:         ErrIncorrectZM,
 	}
 )
 
@@ -67,6 +69,17 @@ func (e *Encoder) Encode(geom geo.Geometry, srid ...int) error {
 	return e.e.Encode(geom, s)
 }
 
+// EncodeZM writes the geometry, with the Z and M values of its points,
+// encoded as EWKB to the given writer.
+func (e *Encoder) EncodeZM(geom geo.Geometry, zm geo.ZM, srid ...int) error {
+	s := e.srid
+	if len(srid) > 0 {
+		s = srid[0]
+	}
+
+	return mapCommonError(e.e.EncodeZM(geom, zm, s))
+}
+
 // Decoder decodes WKB geometry off of the stream.
 type Decoder struct {
 	d *wkbcommon.Decoder
@@ -88,6 +101,16 @@ func (d *Decoder) Decode() (geo.Geometry, int, error) {
 	}
 }
 
+// DecodeZM decodes the next geometry, and the Z and M values
+// of its points, off of the stream.
+func (d *Decoder) DecodeZM() (geo.Geometry, geo.ZM, int, error) {
+	if g, zm, srid, err := d.d.DecodeZM(); err != nil {
+		return nil, geo.ZM{}, 0, mapCommonError(err)
+	} else {
+		return g, zm, srid, nil
+	}
+}
+
 // Marshal encodes the geometry with the given byte order.
 // An SRID of 0 will not be included in the encoding and the
 // result will be a wkb encoding of the geometry.
@@ -110,6 +133,28 @@ func Marshal(geom geo.Geometry, srid int, byteOrder ...binary.ByteOrder) ([]byte
 	return buf.Bytes(), nil
 }
 
+// MarshalZM encodes the geometry, with the Z and M values of its points,
+// with the given byte order. The dimensions are written as the EWKB type flags.
+// An SRID of 0 will not be included in the encoding.
+func MarshalZM(geom geo.Geometry, zm geo.ZM, srid int, byteOrder ...binary.ByteOrder) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, wkbcommon.GeomLength(geom, srid != 0)))
+	e := NewEncoder(buf)
+	e.SetSRID(srid)
+	if len(byteOrder) > 0 {
+		e.SetByteOrder(byteOrder[0])
+	}
+
+	if err := e.EncodeZM(geom, zm); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	return buf.Bytes(), nil
+}
+
 // MarshalToHex encodes the geometry into a hex string representation of the binary ewkb.
 func MarshalToHex(geom geo.Geometry, srid int, byteOrder ...binary.ByteOrder) (string, error) {
 	data, err := Marshal(geom, srid, byteOrder...)
@@ -147,6 +192,15 @@ func Unmarshal(data []byte) (geo.Geometry, int, error) {
 	}
 }
 
+// UnmarshalZM decodes the data into a geometry and the Z and M values of its points.
+func UnmarshalZM(data []byte) (geo.Geometry, geo.ZM, int, error) {
+	if g, zm, srid, err := wkbcommon.UnmarshalZM(data); err != nil {
+		return nil, geo.ZM{}, 0, mapCommonError(err)
+	} else {
+		return g, zm, srid, nil
+	}
+}
+
 func mapCommonError(err error) error {
 	if e, ok := commonErrorMap[err]; ok {
 		return e
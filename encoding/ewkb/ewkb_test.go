@@ -19,6 +19,43 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshalZM(t *testing.T) {
+	ls := geo.LineString{{1, 2}, {4, 5}}
+	zm := geo.ZM{Layout: geo.XYZ, Z: []float64{3, 6}}
+
+	data, err := MarshalZM(ls, zm, 4326)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	// SRID=4326;LINESTRING Z(1 2 3,4 5 6)
+	expected := MustDecodeHex("01020000a0e610000002000000000000000000f03f00000000000000400000000000000840000000000000104000000000000014400000000000001840")
+	if !bytes.Equal(data, expected) {
+		t.Errorf("incorrect encoding: %x", data)
+	}
+
+	g, rzm, srid, err := UnmarshalZM(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !geo.Equal(g, ls) {
+		t.Errorf("incorrect geometry: %v", g)
+	}
+
+	if rzm.Layout != geo.XYZ || rzm.Z[0] != 3 || rzm.Z[1] != 6 {
+		t.Errorf("incorrect zm: %v", rzm)
+	}
+
+	if srid != 4326 {
+		t.Errorf("incorrect srid: %v", srid)
+	}
+
+	if _, err := MarshalZM(ls, geo.NewZM(geo.XYM, 1), 4326); err != ErrIncorrectZM {
+		t.Errorf("incorrect error: %v", err)
+	}
+}
+
 func TestMustMarshal(t *testing.T) {
 	for _, g := range geo.AllGeometries {
 		MustMarshal(g, 0, binary.BigEndian)
@@ -16,12 +16,14 @@ var (
 	ErrIncorrectGeometry   = errors.New("wkb: incorrect geometry")        // returned when unmarshalling WKB data into the wrong type (e.g. linestring data into a point)
 	ErrUnsupportedDataType = errors.New("wkb: scan value must be []byte") // the error returned when scanning a non-byte slice
 	ErrUnsupportedGeometry = errors.New("wkb: unsupported geometry")      // returned when geometry type is not supported by this package
+	ErrIncorrectZM         = errors.New("wkb: incorrect z and m values")  // returned when marshalling with z and m values that do not match the points of the geometry
 	commonErrorMap         = map[error]error{
 		wkbcommon.ErrUnsupportedDataType: ErrUnsupportedDataType,
 		wkbcommon.ErrNotWKB:              ErrNotWKB,
 		wkbcommon.ErrNotWKBHeader:        ErrNotWKB,
 		wkbcommon.ErrIncorrectGeometry:   ErrIncorrectGeometry,
 		wkbcommon.ErrUnsupportedGeometry: ErrUnsupportedGeometry,
+		wkbcommon.ErrIncorrectZM:         ErrIncorrectZM,
 	}
 	DefaultByteOrder binary.ByteOrder = binary.LittleEndian // the order used for marshalling or encoding
 )
@@ -36,6 +38,7 @@ type Encoder struct {
 func NewEncoder(w io.Writer) *Encoder {
 	e := wkbcommon.NewEncoder(w)
 	e.SetByteOrder(DefaultByteOrder)
+	e.SetISO(true)
 	return &Encoder{e: e}
 }
 
@@ -44,6 +47,13 @@ func (e *Encoder) Encode(geom geo.Geometry) error {
 	return e.e.Encode(geom, 0)
 }
 
+// EncodeZM writes the geometry, with the Z and M values of its points,
+// encoded as WKB to the given writer. The dimensions are written
+// using the ISO type codes, e.g. 1001 for a point with Z.
+func (e *Encoder) EncodeZM(geom geo.Geometry, zm geo.ZM) error {
+	return mapCommonError(e.e.EncodeZM(geom, zm, 0))
+}
+
 // SetByteOrder overrides the default byte order set when the encoder was created.
 func (e *Encoder) SetByteOrder(bo binary.ByteOrder) *Encoder {
 	e.e.SetByteOrder(bo)
@@ -71,6 +81,16 @@ func (d *Decoder) Decode() (geo.Geometry, error) {
 	}
 }
 
+// DecodeZM decodes the next geometry, and the Z and M values
+// of its points, off of the stream.
+func (d *Decoder) DecodeZM() (geo.Geometry, geo.ZM, error) {
+	if g, zm, _, err := d.d.DecodeZM(); err != nil {
+		return nil, geo.ZM{}, mapCommonError(err)
+	} else {
+		return g, zm, nil
+	}
+}
+
 // Marshal encodes the geometry with the given byte order.
 func Marshal(geom geo.Geometry, byteOrder ...binary.ByteOrder) ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, wkbcommon.GeomLength(geom, false)))
@@ -90,6 +110,26 @@ func Marshal(geom geo.Geometry, byteOrder ...binary.ByteOrder) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// MarshalZM encodes the geometry, with the Z and M values of its points,
+// with the given byte order.
+func MarshalZM(geom geo.Geometry, zm geo.ZM, byteOrder ...binary.ByteOrder) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, wkbcommon.GeomLength(geom, false)))
+	e := NewEncoder(buf)
+	if len(byteOrder) > 0 {
+		e.SetByteOrder(byteOrder[0])
+	}
+
+	if err := e.EncodeZM(geom, zm); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	return buf.Bytes(), nil
+}
+
 // MarshalToHex encodes the geometry into a hex string representation of the binary wkb.
 func MarshalToHex(geom geo.Geometry, byteOrder ...binary.ByteOrder) (string, error) {
 	if data, err := Marshal(geom, byteOrder...); err != nil {
@@ -126,6 +166,16 @@ func Unmarshal(data []byte) (geo.Geometry, error) {
 	}
 }
 
+// UnmarshalZM decodes the data into a geometry and the Z and M values of its points.
+// Both the ISO type codes and the EWKB flags are accepted.
+func UnmarshalZM(data []byte) (geo.Geometry, geo.ZM, error) {
+	if g, zm, _, err := wkbcommon.UnmarshalZM(data); err != nil {
+		return nil, geo.ZM{}, mapCommonError(err)
+	} else {
+		return g, zm, nil
+	}
+}
+
 func mapCommonError(err error) error {
 	if e, ok := commonErrorMap[err]; ok {
 		return e
@@ -18,6 +18,45 @@ func TestMarshal(t *testing.T) {
 	}
 }
 
+func TestMarshalZM(t *testing.T) {
+	p := geo.Point{1, 2}
+	zm := geo.ZM{Layout: geo.XYZM, Z: []float64{3}, M: []float64{4}}
+
+	data, err := MarshalZM(p, zm)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	// POINT ZM(1 2 3 4) with the ISO type code 3001
+	expected := []byte{
+		1, 0xb9, 0x0b, 0, 0,
+		0, 0, 0, 0, 0, 0, 0xf0, 0x3f,
+		0, 0, 0, 0, 0, 0, 0, 0x40,
+		0, 0, 0, 0, 0, 0, 0x08, 0x40,
+		0, 0, 0, 0, 0, 0, 0x10, 0x40,
+	}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("incorrect encoding: %x", data)
+	}
+
+	g, rzm, err := UnmarshalZM(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !geo.Equal(g, p) {
+		t.Errorf("incorrect geometry: %v", g)
+	}
+
+	if rzm.Layout != geo.XYZM || rzm.Z[0] != 3 || rzm.M[0] != 4 {
+		t.Errorf("incorrect zm: %v", rzm)
+	}
+
+	if g, err := Unmarshal(data); err != nil || !geo.Equal(g, p) {
+		t.Errorf("unmarshal should skip z and m values: %v %v", g, err)
+	}
+}
+
 func TestMustMarshal(t *testing.T) {
 	for _, g := range geo.AllGeometries {
 		MustMarshal(g, binary.BigEndian)
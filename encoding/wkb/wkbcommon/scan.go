@@ -16,6 +16,7 @@ var (
 	ErrIncorrectGeometry   = errors.New("wkbcommon: incorrect geometry")        // returned when unmarshalling WKB data into the wrong type (e. g. linestring into a point)
 	ErrUnsupportedGeometry = errors.New("wkbcommon: unsupported geometry")      // returned when geometry type is not supported by this package
 	ErrUnsupportedDataType = errors.New("wkbcommon: scan value must be []byte") // returned when the data type is not []byte
+	ErrIncorrectZM         = errors.New("wkbcommon: incorrect z and m values")  // returned when marshalling with z and m values that do not match the points of the geometry
 )
 
 // Scan scans the input []byte data into a geometry.
@@ -38,6 +38,7 @@ type Encoder struct {
 	buf   []byte
 	w     io.Writer
 	order binary.ByteOrder
+	iso   bool
 }
 
 // NewEncoder creates a new Encoder for the given writer.
@@ -192,6 +193,17 @@ func (d *Decoder) Decode() (geo.Geometry, int, error) {
 		return nil, 0, err
 	}
 
+	if typ, layout := splitType(typ); layout != geo.XY {
+		// read the extra ordinates so they are not taken for the next point
+		r := &zmReader{r: d.r, order: order, buf: buf, zm: geo.ZM{Layout: layout}}
+		g, err := r.read(typ)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return g, srid, nil
+	}
+
 	var g geo.Geometry
 	switch typ {
 	case pointType:
@@ -221,6 +233,13 @@ func (d *Decoder) Decode() (geo.Geometry, int, error) {
 
 // Unmarshal will decode the type into a Geometry.
 func Unmarshal(data []byte) (geo.Geometry, int, error) {
+	if _, typ, err := byteOrderType(data); err == nil {
+		if _, layout := splitType(typ); layout != geo.XY {
+			g, _, srid, err := UnmarshalZM(data)
+			return g, srid, err
+		}
+	}
+
 	order, typ, srid, geomData, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, 0, err
@@ -384,5 +403,5 @@ func readByteOrderType(r io.Reader, buf []byte) (order byteOrder, typ uint32, sr
 		srid = int(u)
 	}
 
-	return order, typ & 0x0ff, srid, nil
+	return order, typ &^ ewkbType, srid, nil
 }
@@ -0,0 +1,540 @@
+package wkbcommon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+const (
+	ewkbZType uint32 = 0x80000000
+	ewkbMType uint32 = 0x40000000
+)
+
+// EncodeZM will write the geometry, with the Z and M values of its points,
+// encoded as (E)WKB to the given writer. The dimensions are written
+// as EWKB type flags unless the encoder was set to use ISO type codes.
+// Returns ErrIncorrectZM if there is not a value for every point.
+func (e *Encoder) EncodeZM(geom geo.Geometry, zm geo.ZM, srid int) error {
+	if zm.Layout == geo.XY {
+		return e.Encode(geom, srid)
+	}
+
+	// nil values should not write any data
+	var isNil bool
+	switch g := geom.(type) {
+	case nil:
+		isNil = true
+	case geo.MultiPoint:
+		isNil = g == nil
+	case geo.LineString:
+		isNil = g == nil
+	case geo.MultiLineString:
+		isNil = g == nil
+	case geo.Ring:
+		isNil = g == nil
+	case geo.Polygon:
+		isNil = g == nil
+	case geo.MultiPolygon:
+		isNil = g == nil
+	case geo.Collection:
+		isNil = g == nil
+	}
+
+	if isNil {
+		return nil
+	}
+
+	if !zm.Matches(geom) {
+		return ErrIncorrectZM
+	}
+
+	if e.buf == nil {
+		e.buf = make([]byte, 16)
+	}
+
+	w := &zmWriter{e: e, zm: zm}
+	return w.write(geom, srid)
+}
+
+// SetISO makes the encoder write the Z and M dimensions using
+// the ISO type codes, e.g. 1001 for a point with Z, instead of the EWKB flags.
+func (e *Encoder) SetISO(iso bool) {
+	e.iso = iso
+}
+
+// DecodeZM decodes the next geometry, and the Z and M values
+// of its points, off of the stream.
+func (d *Decoder) DecodeZM() (geo.Geometry, geo.ZM, int, error) {
+	buf := make([]byte, 8)
+	order, typ, srid, err := readByteOrderType(d.r, buf)
+	if err != nil {
+		return nil, geo.ZM{}, 0, err
+	}
+
+	typ, layout := splitType(typ)
+	r := &zmReader{r: d.r, order: order, buf: buf, zm: geo.ZM{Layout: layout}}
+	g, err := r.read(typ)
+	if err != nil {
+		return nil, geo.ZM{}, 0, err
+	}
+
+	return g, r.zm, srid, nil
+}
+
+// MarshalZM encodes the geometry, with the Z and M values of its points,
+// with the given byte order. The dimensions are written as EWKB type flags.
+func MarshalZM(geom geo.Geometry, zm geo.ZM, srid int, byteOrder ...binary.ByteOrder) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, GeomLength(geom, srid != 0)))
+	e := NewEncoder(buf)
+	if len(byteOrder) > 0 {
+		e.SetByteOrder(byteOrder[0])
+	}
+
+	if err := e.EncodeZM(geom, zm, srid); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalZM will decode the data into a geometry
+// and the Z and M values of its points.
+func UnmarshalZM(data []byte) (geo.Geometry, geo.ZM, int, error) {
+	g, zm, srid, err := NewDecoder(bytes.NewReader(data)).DecodeZM()
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, geo.ZM{}, 0, ErrNotWKB
+	}
+
+	return g, zm, srid, err
+}
+
+// splitType returns the base geometry type and the layout
+// given by either the EWKB flags or the ISO type code.
+func splitType(typ uint32) (uint32, geo.Layout) {
+	z := typ&ewkbZType != 0
+	m := typ&ewkbMType != 0
+	typ &= 0x0fffffff
+	switch typ / 1000 {
+	case 1:
+		z = true
+	case 2:
+		m = true
+	case 3:
+		z, m = true, true
+	}
+
+	layout := geo.XY
+	switch {
+	case z && m:
+		layout = geo.XYZM
+	case z:
+		layout = geo.XYZ
+	case m:
+		layout = geo.XYM
+	}
+
+	return typ % 1000, layout
+}
+
+type zmWriter struct {
+	e  *Encoder
+	zm geo.ZM
+	i  int
+}
+
+func (w *zmWriter) write(geom geo.Geometry, srid int) error {
+	switch g := geom.(type) {
+	case geo.Ring:
+		geom = geo.Polygon{g}
+	case geo.Bound:
+		geom = g.ToPolygon()
+	}
+
+	if w.e.order == binary.LittleEndian {
+		w.e.buf[0] = 1
+	} else {
+		w.e.buf[0] = 0
+	}
+
+	if _, err := w.e.w.Write(w.e.buf[:1]); err != nil {
+		return err
+	}
+
+	switch g := geom.(type) {
+	case geo.Point:
+		if err := w.writeType(pointType, srid); err != nil {
+			return err
+		}
+
+		return w.writePoint(g)
+	case geo.MultiPoint:
+		if err := w.writePrefix(multiPointType, len(g), srid); err != nil {
+			return err
+		}
+
+		for _, p := range g {
+			if err := w.write(p, 0); err != nil {
+				return err
+			}
+		}
+	case geo.LineString:
+		if err := w.writePrefix(lineStringType, len(g), srid); err != nil {
+			return err
+		}
+
+		return w.writePoints(g)
+	case geo.MultiLineString:
+		if err := w.writePrefix(multiLineStringType, len(g), srid); err != nil {
+			return err
+		}
+
+		for _, ls := range g {
+			if err := w.write(ls, 0); err != nil {
+				return err
+			}
+		}
+	case geo.Polygon:
+		if err := w.writePrefix(polygonType, len(g), srid); err != nil {
+			return err
+		}
+
+		for _, r := range g {
+			if err := w.writeCount(len(r)); err != nil {
+				return err
+			}
+
+			if err := w.writePoints(geo.LineString(r)); err != nil {
+				return err
+			}
+		}
+	case geo.MultiPolygon:
+		if err := w.writePrefix(multiPolygonType, len(g), srid); err != nil {
+			return err
+		}
+
+		for _, p := range g {
+			if err := w.write(p, 0); err != nil {
+				return err
+			}
+		}
+	case geo.Collection:
+		if err := w.writePrefix(geometryCollectionType, len(g), srid); err != nil {
+			return err
+		}
+
+		for _, c := range g {
+			if err := w.write(c, 0); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrUnsupportedGeometry
+	}
+
+	return nil
+}
+
+func (w *zmWriter) writeType(t uint32, srid int) error {
+	layout := w.zm.Layout
+	if w.e.iso {
+		switch layout {
+		case geo.XYZ:
+			t += 1000
+		case geo.XYM:
+			t += 2000
+		case geo.XYZM:
+			t += 3000
+		}
+	} else {
+		if layout.HasZ() {
+			t |= ewkbZType
+		}
+
+		if layout.HasM() {
+			t |= ewkbMType
+		}
+	}
+
+	if srid == 0 {
+		w.e.order.PutUint32(w.e.buf, t)
+		_, err := w.e.w.Write(w.e.buf[:4])
+		return err
+	}
+
+	w.e.order.PutUint32(w.e.buf, t|ewkbType)
+	w.e.order.PutUint32(w.e.buf[4:], uint32(srid))
+	_, err := w.e.w.Write(w.e.buf[:8])
+	return err
+}
+
+func (w *zmWriter) writePrefix(t uint32, l int, srid int) error {
+	if err := w.writeType(t, srid); err != nil {
+		return err
+	}
+
+	return w.writeCount(l)
+}
+
+func (w *zmWriter) writeCount(l int) error {
+	w.e.order.PutUint32(w.e.buf, uint32(l))
+	_, err := w.e.w.Write(w.e.buf[:4])
+	return err
+}
+
+func (w *zmWriter) writePoints(ls geo.LineString) error {
+	for _, p := range ls {
+		if err := w.writePoint(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *zmWriter) writePoint(p geo.Point) error {
+	if err := w.writeFloat(p[0]); err != nil {
+		return err
+	}
+
+	if err := w.writeFloat(p[1]); err != nil {
+		return err
+	}
+
+	if w.zm.Layout.HasZ() {
+		if err := w.writeFloat(w.zm.Z[w.i]); err != nil {
+			return err
+		}
+	}
+
+	if w.zm.Layout.HasM() {
+		if err := w.writeFloat(w.zm.M[w.i]); err != nil {
+			return err
+		}
+	}
+
+	w.i++
+	return nil
+}
+
+func (w *zmWriter) writeFloat(f float64) error {
+	w.e.order.PutUint64(w.e.buf, math.Float64bits(f))
+	_, err := w.e.w.Write(w.e.buf[:8])
+	return err
+}
+
+type zmReader struct {
+	r     io.Reader
+	order byteOrder
+	buf   []byte
+	zm    geo.ZM
+}
+
+// read reads the body of a geometry of the given type,
+// the header has already been read.
+func (r *zmReader) read(typ uint32) (geo.Geometry, error) {
+	switch typ {
+	case pointType:
+		return r.readPoint()
+	case multiPointType:
+		num, alloc, err := r.readCount(MaxPointsAlloc)
+		if err != nil {
+			return nil, err
+		}
+
+		mp := make(geo.MultiPoint, 0, alloc)
+		for i := 0; i < num; i++ {
+			g, err := r.readNested(pointType)
+			if err != nil {
+				return nil, err
+			}
+
+			mp = append(mp, g.(geo.Point))
+		}
+
+		return mp, nil
+	case lineStringType:
+		return r.readPoints()
+	case multiLineStringType:
+		num, alloc, err := r.readCount(MaxMultiAlloc)
+		if err != nil {
+			return nil, err
+		}
+
+		mls := make(geo.MultiLineString, 0, alloc)
+		for i := 0; i < num; i++ {
+			g, err := r.readNested(lineStringType)
+			if err != nil {
+				return nil, err
+			}
+
+			mls = append(mls, g.(geo.LineString))
+		}
+
+		return mls, nil
+	case polygonType:
+		num, alloc, err := r.readCount(MaxMultiAlloc)
+		if err != nil {
+			return nil, err
+		}
+
+		p := make(geo.Polygon, 0, alloc)
+		for i := 0; i < num; i++ {
+			ls, err := r.readPoints()
+			if err != nil {
+				return nil, err
+			}
+
+			p = append(p, geo.Ring(ls))
+		}
+
+		return p, nil
+	case multiPolygonType:
+		num, alloc, err := r.readCount(MaxMultiAlloc)
+		if err != nil {
+			return nil, err
+		}
+
+		mp := make(geo.MultiPolygon, 0, alloc)
+		for i := 0; i < num; i++ {
+			g, err := r.readNested(polygonType)
+			if err != nil {
+				return nil, err
+			}
+
+			mp = append(mp, g.(geo.Polygon))
+		}
+
+		return mp, nil
+	case geometryCollectionType:
+		num, alloc, err := r.readCount(MaxMultiAlloc)
+		if err != nil {
+			return nil, err
+		}
+
+		c := make(geo.Collection, 0, alloc)
+		for i := 0; i < num; i++ {
+			g, err := r.readNested(0)
+			if err != nil {
+				return nil, err
+			}
+
+			c = append(c, g)
+		}
+
+		return c, nil
+	}
+
+	return nil, ErrUnsupportedGeometry
+}
+
+// readNested reads a member of a multi-geometry or collection,
+// it must have the same layout as the parent and the expected type if not 0.
+func (r *zmReader) readNested(expected uint32) (geo.Geometry, error) {
+	order, typ, _, err := readByteOrderType(r.r, r.buf)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, layout := splitType(typ)
+	if layout != r.zm.Layout {
+		return nil, ErrNotWKB
+	}
+
+	if expected != 0 && typ != expected {
+		return nil, ErrNotWKB
+	}
+
+	parent := r.order
+	r.order = order
+	g, err := r.read(typ)
+	r.order = parent
+
+	return g, err
+}
+
+// readCount returns the number of elements and how many to allocate space for.
+func (r *zmReader) readCount(max int) (int, int, error) {
+	num, err := readUint32(r.r, r.order, r.buf[:4])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	alloc := int(num)
+	if num > uint32(max) {
+		// invalid data can come in here and allocate tons of memory.
+		alloc = max
+	}
+
+	return int(num), alloc, nil
+}
+
+func (r *zmReader) readPoints() (geo.LineString, error) {
+	num, alloc, err := r.readCount(MaxPointsAlloc)
+	if err != nil {
+		return nil, err
+	}
+
+	ls := make(geo.LineString, 0, alloc)
+	for i := 0; i < num; i++ {
+		p, err := r.readPoint()
+		if err != nil {
+			return nil, err
+		}
+
+		ls = append(ls, p)
+	}
+
+	return ls, nil
+}
+
+func (r *zmReader) readPoint() (geo.Point, error) {
+	var p geo.Point
+	var err error
+	if p[0], err = r.readFloat(); err != nil {
+		return geo.Point{}, err
+	}
+
+	if p[1], err = r.readFloat(); err != nil {
+		return geo.Point{}, err
+	}
+
+	if r.zm.Layout.HasZ() {
+		z, err := r.readFloat()
+		if err != nil {
+			return geo.Point{}, err
+		}
+
+		r.zm.Z = append(r.zm.Z, z)
+	}
+
+	if r.zm.Layout.HasM() {
+		m, err := r.readFloat()
+		if err != nil {
+			return geo.Point{}, err
+		}
+
+		r.zm.M = append(r.zm.M, m)
+	}
+
+	return p, nil
+}
+
+func (r *zmReader) readFloat() (float64, error) {
+	if _, err := io.ReadFull(r.r, r.buf[:8]); err != nil {
+		return 0, err
+	}
+
+	if r.order == littleEndian {
+		return math.Float64frombits(binary.LittleEndian.Uint64(r.buf[:8])), nil
+	}
+
+	return math.Float64frombits(binary.BigEndian.Uint64(r.buf[:8])), nil
+}
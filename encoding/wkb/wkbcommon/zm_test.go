@@ -0,0 +1,153 @@
+package wkbcommon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestMarshalZM(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		if _, err := MarshalZM(g, geo.NewZM(geo.XYZM, geo.PointCount(g)), 0, binary.BigEndian); err != nil {
+			t.Fatalf("unexpected error: %e", err)
+		}
+	}
+
+	_, err := MarshalZM(geo.LineString{{1, 2}, {3, 4}}, geo.NewZM(geo.XYZ, 1), 0)
+	if err != ErrIncorrectZM {
+		t.Errorf("incorrect error: %v", err)
+	}
+}
+
+func TestUnmarshalZM(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		geom geo.Geometry
+		zm   geo.ZM
+		srid int
+	}{
+		{
+			name: "ewkb point z",
+			data: "01010000a0e6100000000000000000f03f00000000000000400000000000000840",
+			geom: geo.Point{1, 2},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{3}},
+			srid: 4326,
+		},
+		{
+			name: "iso point z",
+			data: "01e9030000000000000000f03f00000000000000400000000000000840",
+			geom: geo.Point{1, 2},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{3}},
+		},
+		{
+			name: "iso line string m",
+			data: "01d2070000020000000000000000000000000000000000000000000000000014400000000000000000000000000000f03f0000000000002440",
+			geom: geo.LineString{{0, 0}, {0, 1}},
+			zm:   geo.ZM{Layout: geo.XYM, M: []float64{5, 10}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.data)
+			if err != nil {
+				t.Fatalf("invalid hex: %v", err)
+			}
+
+			g, zm, srid, err := UnmarshalZM(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !geo.Equal(g, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.geom)
+			}
+
+			if !reflect.DeepEqual(zm, tc.zm) {
+				t.Errorf("incorrect zm: %v != %v", zm, tc.zm)
+			}
+
+			if srid != tc.srid {
+				t.Errorf("incorrect srid: %v != %v", srid, tc.srid)
+			}
+
+			// the extra ordinates are skipped when only the geometry is wanted
+			g, srid, err = Unmarshal(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !geo.Equal(g, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.geom)
+			}
+
+			if srid != tc.srid {
+				t.Errorf("incorrect srid: %v != %v", srid, tc.srid)
+			}
+		})
+	}
+}
+
+func TestZM_roundTrip(t *testing.T) {
+	geoms := []geo.Geometry{
+		geo.Point{1, 2},
+		geo.MultiPoint{{1, 2}, {3, 4}},
+		geo.LineString{{1, 2}, {3, 4}, {5, 6}},
+		geo.MultiLineString{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}},
+		geo.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		geo.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}, {{{2, 2}, {3, 2}, {3, 3}, {2, 2}}}},
+		geo.Collection{geo.Point{1, 2}, geo.LineString{{1, 2}, {3, 4}}},
+	}
+
+	for _, g := range geoms {
+		for _, layout := range []geo.Layout{geo.XYZ, geo.XYM, geo.XYZM} {
+			zm := geo.NewZM(layout, geo.PointCount(g))
+			for i := range zm.Z {
+				zm.Z[i] = float64(i + 10)
+			}
+
+			for i := range zm.M {
+				zm.M[i] = float64(i + 100)
+			}
+
+			for _, iso := range []bool{false, true} {
+				for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+					buf := bytes.NewBuffer(nil)
+					e := NewEncoder(buf)
+					e.SetByteOrder(order)
+					e.SetISO(iso)
+					if err := e.EncodeZM(g, zm, 0); err != nil {
+						t.Fatalf("encode error: %v", err)
+					}
+
+					rg, rzm, _, err := UnmarshalZM(buf.Bytes())
+					if err != nil {
+						t.Fatalf("%T %v: unmarshal error: %v", g, layout, err)
+					}
+
+					if !geo.Equal(rg, g) {
+						t.Errorf("%T %v: incorrect geometry: %v != %v", g, layout, rg, g)
+					}
+
+					if !reflect.DeepEqual(rzm, zm) {
+						t.Errorf("%T %v: incorrect zm: %v != %v", g, layout, rzm, zm)
+					}
+
+					rg, _, err = NewDecoder(bytes.NewReader(buf.Bytes())).Decode()
+					if err != nil {
+						t.Fatalf("%T %v: decode error: %v", g, layout, err)
+					}
+
+					if !geo.Equal(rg, g) {
+						t.Errorf("%T %v: incorrect decoded geometry: %v != %v", g, layout, rg, g)
+					}
+				}
+			}
+		}
+	}
+}
@@ -19,7 +19,21 @@ var (
 )
 
 // Unmarshal returns a geometry by parsing the WKT string.
+// Z and M values, e.g. POINT Z(1 2 3), are dropped,
+// use UnmarshalZM to keep them.
 func Unmarshal(s string) (geo.Geometry, error) {
+	g, err := unmarshal(s)
+	if err != nil {
+		// try again allowing for the extra ordinates given by a tag
+		if g, _, tagged, zerr := unmarshalZM(s); zerr == nil && tagged {
+			return g, nil
+		}
+	}
+
+	return g, err
+}
+
+func unmarshal(s string) (geo.Geometry, error) {
 	s = trimSpace(s)
 	prefix := upperPrefix(s)
 	if bytes.HasPrefix(prefix, []byte("POINT")) {
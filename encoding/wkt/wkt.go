@@ -10,133 +10,206 @@ import (
 // Marshal returns a WKT representation of the geometry.
 func Marshal(g geo.Geometry) []byte {
 	buf := bytes.NewBuffer(nil)
-	wkt(buf, g)
+	w := &writer{buf: buf}
+	w.wkt(g)
 	return buf.Bytes()
 }
 
 // MarshalString returns a WKT representation of the geometry as a string.
 func MarshalString(g geo.Geometry) string {
 	buf := bytes.NewBuffer(nil)
-	wkt(buf, g)
+	w := &writer{buf: buf}
+	w.wkt(g)
 	return buf.String()
 }
 
-func writeLineString(buf *bytes.Buffer, ls geo.LineString) {
-	buf.WriteByte('(')
+// MarshalZM returns a WKT representation of the geometry with
+// the Z and M values of its points, e.g. POINT Z(1 2 3).
+// Panics if there is not a value for every point.
+func MarshalZM(g geo.Geometry, zm geo.ZM) []byte {
+	buf := bytes.NewBuffer(nil)
+	newZMWriter(buf, g, zm).wkt(g)
+	return buf.Bytes()
+}
+
+// MarshalStringZM returns a WKT representation of the geometry with
+// the Z and M values of its points as a string.
+// Panics if there is not a value for every point.
+func MarshalStringZM(g geo.Geometry, zm geo.ZM) string {
+	buf := bytes.NewBuffer(nil)
+	newZMWriter(buf, g, zm).wkt(g)
+	return buf.String()
+}
+
+// writer writes the geometry, and the Z and M values
+// of its points in the order they are visited.
+type writer struct {
+	buf *bytes.Buffer
+	zm  geo.ZM
+	i   int
+}
+
+func newZMWriter(buf *bytes.Buffer, g geo.Geometry, zm geo.ZM) *writer {
+	if !zm.Matches(g) {
+		panic("wkt: z and m values do not match the points of the geometry")
+	}
+
+	return &writer{buf: buf, zm: zm}
+}
+
+// tag writes the type name followed by the layout, if any.
+func (w *writer) tag(name string) {
+	w.buf.WriteString(name)
+	if w.zm.Layout != geo.XY {
+		w.buf.WriteByte(' ')
+		w.buf.WriteString(w.zm.Layout.String())
+	}
+}
+
+func (w *writer) empty(name string) {
+	w.tag(name)
+	w.buf.WriteString(" EMPTY")
+}
+
+func (w *writer) point(p geo.Point) {
+	fmt.Fprintf(w.buf, "%g %g", p[0], p[1])
+	if w.zm.Layout.HasZ() {
+		fmt.Fprintf(w.buf, " %g", w.zm.Z[w.i])
+	}
+
+	if w.zm.Layout.HasM() {
+		fmt.Fprintf(w.buf, " %g", w.zm.M[w.i])
+	}
+
+	w.i++
+}
+
+func (w *writer) lineString(ls geo.LineString) {
+	w.buf.WriteByte('(')
 	for i, p := range ls {
 		if i != 0 {
-			buf.WriteByte(',')
+			w.buf.WriteByte(',')
 		}
 
-		fmt.Fprintf(buf, "%g %g", p[0], p[1])
+		w.point(p)
 	}
-	buf.WriteByte(')')
+	w.buf.WriteByte(')')
 }
 
-func wkt(buf *bytes.Buffer, geom geo.Geometry) {
+func (w *writer) wkt(geom geo.Geometry) {
 	switch g := geom.(type) {
 	case geo.Point:
-		fmt.Fprintf(buf, "POINT(%g %g)", g[0], g[1])
+		w.tag("POINT")
+		w.buf.WriteByte('(')
+		w.point(g)
+		w.buf.WriteByte(')')
 	case geo.MultiPoint:
 		if len(g) == 0 {
-			buf.Write([]byte(`MULTIPOINT EMPTY`))
+			w.empty("MULTIPOINT")
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOINT(`))
+		w.tag("MULTIPOINT")
+		w.buf.WriteByte('(')
 		for i, p := range g {
 			if i != 0 {
-				buf.WriteByte(',')
+				w.buf.WriteByte(',')
 			}
 
-			fmt.Fprintf(buf, "(%g %g)", p[0], p[1])
+			w.buf.WriteByte('(')
+			w.point(p)
+			w.buf.WriteByte(')')
 		}
 
-		buf.WriteByte(')')
+		w.buf.WriteByte(')')
 	case geo.LineString:
 		if len(g) == 0 {
-			buf.Write([]byte(`LINESTRING EMPTY`))
+			w.empty("LINESTRING")
 			return
 		}
 
-		buf.Write([]byte(`LINESTRING`))
-		writeLineString(buf, g)
+		w.tag("LINESTRING")
+		w.lineString(g)
 	case geo.MultiLineString:
 		if len(g) == 0 {
-			buf.Write([]byte(`MULTILINESTRING EMPTY`))
+			w.empty("MULTILINESTRING")
 			return
 		}
 
-		buf.Write([]byte(`MULTILINESTRING(`))
+		w.tag("MULTILINESTRING")
+		w.buf.WriteByte('(')
 		for i, ls := range g {
 			if i != 0 {
-				buf.WriteByte(',')
+				w.buf.WriteByte(',')
 			}
 
-			writeLineString(buf, ls)
+			w.lineString(ls)
 		}
 
-		buf.WriteByte(')')
+		w.buf.WriteByte(')')
 	case geo.Ring:
-		wkt(buf, geo.Polygon{g})
+		w.wkt(geo.Polygon{g})
 	case geo.Polygon:
 		if len(g) == 0 {
-			buf.Write([]byte(`POLYGON EMPTY`))
+			w.empty("POLYGON")
 			return
 		}
 
-		buf.Write([]byte(`POLYGON(`))
+		w.tag("POLYGON")
+		w.buf.WriteByte('(')
 		for i, r := range g {
 			if i != 0 {
-				buf.WriteByte(',')
+				w.buf.WriteByte(',')
 			}
-			writeLineString(buf, geo.LineString(r))
+			w.lineString(geo.LineString(r))
 		}
 
-		buf.WriteByte(')')
+		w.buf.WriteByte(')')
 	case geo.MultiPolygon:
 		if len(g) == 0 {
-			buf.Write([]byte(`MULTIPOLYGON EMPTY`))
+			w.empty("MULTIPOLYGON")
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOLYGON(`))
+		w.tag("MULTIPOLYGON")
+		w.buf.WriteByte('(')
 		for i, p := range g {
 			if i != 0 {
-				buf.WriteByte(',')
+				w.buf.WriteByte(',')
 			}
 
-			buf.WriteByte('(')
+			w.buf.WriteByte('(')
 			for j, r := range p {
 				if j != 0 {
-					buf.WriteByte(',')
+					w.buf.WriteByte(',')
 				}
 
-				writeLineString(buf, geo.LineString(r))
+				w.lineString(geo.LineString(r))
 			}
 
-			buf.WriteByte(')')
+			w.buf.WriteByte(')')
 		}
 
-		buf.WriteByte(')')
+		w.buf.WriteByte(')')
 	case geo.Collection:
 		if len(g) == 0 {
-			buf.Write([]byte(`GEOMETRYCOLLECTION EMPTY`))
+			w.empty("GEOMETRYCOLLECTION")
 			return
 		}
 
-		buf.Write([]byte(`GEOMETRYCOLLECTION(`))
+		w.tag("GEOMETRYCOLLECTION")
+		w.buf.WriteByte('(')
 		for i, c := range g {
 			if i != 0 {
-				buf.WriteByte(',')
+				w.buf.WriteByte(',')
 			}
 
-			wkt(buf, c)
+			w.wkt(c)
 		}
 
-		buf.WriteByte(')')
+		w.buf.WriteByte(')')
 	case geo.Bound:
-		wkt(buf, g.ToPolygon())
+		w.wkt(g.ToPolygon())
 	default:
 		panic("unsupported type")
 	}
@@ -0,0 +1,376 @@
+package wkt
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pchchv/geo"
+)
+
+var typeNames = []string{
+	"POINT",
+	"MULTIPOINT",
+	"LINESTRING",
+	"MULTILINESTRING",
+	"POLYGON",
+	"MULTIPOLYGON",
+	"GEOMETRYCOLLECTION",
+}
+
+// UnmarshalZM returns the geometry, and the Z and M values of its points,
+// by parsing the WKT string. The layout is given by the Z, M or ZM tag after
+// the geometry type, e.g. POINT Z(1 2 3). Without a tag, points with
+// 3 or 4 coordinates are read as XYZ and XYZM.
+func UnmarshalZM(s string) (geo.Geometry, geo.ZM, error) {
+	g, zm, _, err := unmarshalZM(s)
+	return g, zm, err
+}
+
+// unmarshalZM also returns if the layout was given by a tag.
+func unmarshalZM(s string) (geo.Geometry, geo.ZM, bool, error) {
+	p := &parser{s: s}
+	g, err := p.geometry()
+	if err != nil {
+		return nil, geo.ZM{}, false, err
+	}
+
+	if p.skipSpace(); p.pos != len(p.s) {
+		return nil, geo.ZM{}, false, ErrNotWKT
+	}
+
+	return g, geo.ZM{Layout: p.layout, Z: p.z, M: p.m}, p.tagged, nil
+}
+
+// parser reads the WKT string token by token, collecting
+// the Z and M values of the points as they are visited.
+type parser struct {
+	s      string
+	pos    int
+	layout geo.Layout
+	known  bool // the layout has been set by a tag or the first point
+	tagged bool
+	z, m   []float64
+}
+
+func (p *parser) geometry() (geo.Geometry, error) {
+	name, layout, tagged := splitTypeName(p.word())
+	if tagged {
+		if err := p.setLayout(layout); err != nil {
+			return nil, err
+		}
+	}
+
+	empty, err := p.header()
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case "POINT":
+		if empty {
+			// there is no empty point
+			return nil, ErrNotWKT
+		}
+
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+
+		pt, err := p.point()
+		if err != nil {
+			return nil, err
+		}
+
+		return pt, p.expect(')')
+	case "MULTIPOINT":
+		mp := geo.MultiPoint{}
+		if empty {
+			return mp, nil
+		}
+
+		err := p.list(func() error {
+			// the points may or may not be in brackets
+			bracket := p.peek() == '('
+			if bracket {
+				p.pos++
+			}
+
+			pt, err := p.point()
+			if err != nil {
+				return err
+			}
+
+			mp = append(mp, pt)
+			if bracket {
+				return p.expect(')')
+			}
+
+			return nil
+		})
+
+		return mp, err
+	case "LINESTRING":
+		if empty {
+			return geo.LineString{}, nil
+		}
+
+		return p.lineString()
+	case "MULTILINESTRING":
+		mls := geo.MultiLineString{}
+		if empty {
+			return mls, nil
+		}
+
+		err := p.list(func() error {
+			ls, err := p.lineString()
+			mls = append(mls, ls)
+			return err
+		})
+
+		return mls, err
+	case "POLYGON":
+		if empty {
+			return geo.Polygon{}, nil
+		}
+
+		return p.polygon()
+	case "MULTIPOLYGON":
+		mp := geo.MultiPolygon{}
+		if empty {
+			return mp, nil
+		}
+
+		err := p.list(func() error {
+			poly, err := p.polygon()
+			mp = append(mp, poly)
+			return err
+		})
+
+		return mp, err
+	case "GEOMETRYCOLLECTION":
+		c := geo.Collection{}
+		if empty {
+			return c, nil
+		}
+
+		err := p.list(func() error {
+			g, err := p.geometry()
+			c = append(c, g)
+			return err
+		})
+
+		return c, err
+	}
+
+	return nil, ErrUnsupportedGeometry
+}
+
+// header reads the optional layout tag and EMPTY keyword after the type name.
+func (p *parser) header() (bool, error) {
+	w := p.word()
+	if layout, ok := parseLayout(w); ok {
+		if err := p.setLayout(layout); err != nil {
+			return false, err
+		}
+
+		w = p.word()
+	}
+
+	switch w {
+	case "":
+		return false, nil
+	case "EMPTY":
+		return true, nil
+	}
+
+	return false, ErrNotWKT
+}
+
+func (p *parser) setLayout(layout geo.Layout) error {
+	if p.known && p.layout != layout {
+		return ErrNotWKT
+	}
+
+	p.layout, p.known, p.tagged = layout, true, true
+	return nil
+}
+
+// list reads a bracketed, comma separated list of items.
+func (p *parser) list(item func() error) error {
+	if err := p.expect('('); err != nil {
+		return err
+	}
+
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return nil
+		default:
+			return ErrNotWKT
+		}
+	}
+}
+
+func (p *parser) lineString() (geo.LineString, error) {
+	ls := geo.LineString{}
+	err := p.list(func() error {
+		pt, err := p.point()
+		ls = append(ls, pt)
+		return err
+	})
+
+	return ls, err
+}
+
+func (p *parser) polygon() (geo.Polygon, error) {
+	poly := geo.Polygon{}
+	err := p.list(func() error {
+		ls, err := p.lineString()
+		poly = append(poly, geo.Ring(ls))
+		return err
+	})
+
+	return poly, err
+}
+
+func (p *parser) point() (geo.Point, error) {
+	var values [4]float64
+	n := 0
+	for {
+		if c := p.peek(); c == ',' || c == ')' || c == 0 {
+			break
+		}
+
+		if n == len(values) {
+			return geo.Point{}, ErrNotWKT
+		}
+
+		start := p.pos
+		for p.pos < len(p.s) && !isDelimiter(p.s[p.pos]) {
+			p.pos++
+		}
+
+		v, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+		if err != nil {
+			return geo.Point{}, ErrNotWKT
+		}
+
+		values[n] = v
+		n++
+	}
+
+	if !p.known {
+		switch n {
+		case 3:
+			p.layout = geo.XYZ
+		case 4:
+			p.layout = geo.XYZM
+		}
+
+		p.known = true
+	}
+
+	i := 2
+	if p.layout.HasZ() {
+		p.z = append(p.z, values[i])
+		i++
+	}
+
+	if p.layout.HasM() {
+		p.m = append(p.m, values[i])
+		i++
+	}
+
+	if n != i {
+		return geo.Point{}, ErrNotWKT
+	}
+
+	return geo.Point{values[0], values[1]}, nil
+}
+
+// word reads the next run of letters, in upper case.
+func (p *parser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && isLetter(p.s[p.pos]) {
+		p.pos++
+	}
+
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+// peek returns the next non space character, or 0 at the end of the string.
+func (p *parser) peek() byte {
+	p.skipSpace()
+	if p.pos == len(p.s) {
+		return 0
+	}
+
+	return p.s[p.pos]
+}
+
+func (p *parser) expect(c byte) error {
+	if p.peek() != c {
+		return ErrNotWKT
+	}
+
+	p.pos++
+	return nil
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) {
+		if v := p.s[p.pos]; v != ' ' && v != '\t' && v != '\n' && v != '\r' {
+			return
+		}
+
+		p.pos++
+	}
+}
+
+// splitTypeName splits a layout suffix from the type name, e.g. POINTZ.
+func splitTypeName(w string) (string, geo.Layout, bool) {
+	for _, name := range typeNames {
+		if w == name {
+			return w, geo.XY, false
+		}
+	}
+
+	for _, name := range typeNames {
+		if strings.HasPrefix(w, name) {
+			if layout, ok := parseLayout(w[len(name):]); ok {
+				return name, layout, true
+			}
+		}
+	}
+
+	return w, geo.XY, false
+}
+
+func parseLayout(w string) (geo.Layout, bool) {
+	switch w {
+	case "Z":
+		return geo.XYZ, true
+	case "M":
+		return geo.XYM, true
+	case "ZM":
+		return geo.XYZM, true
+	}
+
+	return geo.XY, false
+}
+
+func isLetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDelimiter(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' || c == '(' || c == ')'
+}
@@ -0,0 +1,190 @@
+package wkt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestMarshalStringZM(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     geo.Geometry
+		zm       geo.ZM
+		expected string
+	}{
+		{
+			name:     "point z",
+			geom:     geo.Point{1, 2},
+			zm:       geo.ZM{Layout: geo.XYZ, Z: []float64{3}},
+			expected: "POINT Z(1 2 3)",
+		},
+		{
+			name:     "line string m",
+			geom:     geo.LineString{{1, 2}, {3, 4}},
+			zm:       geo.ZM{Layout: geo.XYM, M: []float64{5, 6}},
+			expected: "LINESTRING M(1 2 5,3 4 6)",
+		},
+		{
+			name:     "polygon zm",
+			geom:     geo.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+			zm:       geo.ZM{Layout: geo.XYZM, Z: []float64{1, 2, 3, 1}, M: []float64{0, 1, 2, 3}},
+			expected: "POLYGON ZM((0 0 1 0,1 0 2 1,1 1 3 2,0 0 1 3))",
+		},
+		{
+			name:     "empty",
+			geom:     geo.MultiPoint{},
+			zm:       geo.ZM{Layout: geo.XYZ},
+			expected: "MULTIPOINT Z EMPTY",
+		},
+		{
+			name:     "collection",
+			geom:     geo.Collection{geo.Point{1, 2}, geo.MultiPoint{{3, 4}}},
+			zm:       geo.ZM{Layout: geo.XYZ, Z: []float64{5, 6}},
+			expected: "GEOMETRYCOLLECTION Z(POINT Z(1 2 5),MULTIPOINT Z((3 4 6)))",
+		},
+		{
+			name:     "no z or m",
+			geom:     geo.Point{1, 2},
+			expected: "POINT(1 2)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := MarshalStringZM(tc.geom, tc.zm)
+			if v != tc.expected {
+				t.Fatalf("incorrect wkt: %v != %v", v, tc.expected)
+			}
+
+			g, zm, err := UnmarshalZM(v)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !geo.Equal(g, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.geom)
+			}
+
+			if zm.Layout != tc.zm.Layout ||
+				len(zm.Z) != len(tc.zm.Z) || len(zm.M) != len(tc.zm.M) ||
+				(len(zm.Z) > 0 && !reflect.DeepEqual(zm.Z, tc.zm.Z)) ||
+				(len(zm.M) > 0 && !reflect.DeepEqual(zm.M, tc.zm.M)) {
+				t.Errorf("incorrect zm: %v != %v", zm, tc.zm)
+			}
+
+			// the values are dropped by the 2d unmarshal
+			g, err = Unmarshal(v)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !geo.Equal(g, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.geom)
+			}
+		})
+	}
+}
+
+func TestUnmarshalZM(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		geom geo.Geometry
+		zm   geo.ZM
+	}{
+		{
+			name: "untagged z",
+			s:    "LINESTRING(1 2 3, 4 5 6)",
+			geom: geo.LineString{{1, 2}, {4, 5}},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{3, 6}},
+		},
+		{
+			name: "untagged zm",
+			s:    "POINT(1 2 3 4)",
+			geom: geo.Point{1, 2},
+			zm:   geo.ZM{Layout: geo.XYZM, Z: []float64{3}, M: []float64{4}},
+		},
+		{
+			name: "tag without space",
+			s:    "pointm (1 2 3)",
+			geom: geo.Point{1, 2},
+			zm:   geo.ZM{Layout: geo.XYM, M: []float64{3}},
+		},
+		{
+			name: "multi point without brackets",
+			s:    "MULTIPOINT Z (1 2 3, 4 5 6)",
+			geom: geo.MultiPoint{{1, 2}, {4, 5}},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{3, 6}},
+		},
+		{
+			name: "multi polygon",
+			s:    "MULTIPOLYGON Z (((0 0 1,1 0 1,1 1 1,0 0 1)),((5 5 2,6 5 2,6 6 2,5 5 2)))",
+			geom: geo.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{5, 5}, {6, 5}, {6, 6}, {5, 5}}},
+			},
+			zm: geo.ZM{Layout: geo.XYZ, Z: []float64{1, 1, 1, 1, 2, 2, 2, 2}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, zm, err := UnmarshalZM(tc.s)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !geo.Equal(g, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.geom)
+			}
+
+			if !reflect.DeepEqual(zm, tc.zm) {
+				t.Errorf("incorrect zm: %v != %v", zm, tc.zm)
+			}
+		})
+	}
+}
+
+func TestUnmarshalZM_errors(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		err  error
+	}{
+		{
+			name: "missing z value",
+			s:    "LINESTRING Z(1 2 3,4 5)",
+			err:  ErrNotWKT,
+		},
+		{
+			name: "too many values",
+			s:    "POINT Z(1 2 3 4)",
+			err:  ErrNotWKT,
+		},
+		{
+			name: "different layouts",
+			s:    "GEOMETRYCOLLECTION Z(POINT M(1 2 3))",
+			err:  ErrNotWKT,
+		},
+		{
+			name: "trailing data",
+			s:    "POINT Z(1 2 3))",
+			err:  ErrNotWKT,
+		},
+		{
+			name: "unsupported",
+			s:    "TRIANGLE Z((0 0 0,1 0 0,0 1 0,0 0 0))",
+			err:  ErrUnsupportedGeometry,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := UnmarshalZM(tc.s); err != tc.err {
+				t.Errorf("incorrect error: %v != %v", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package geodesic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// areaStep is the max length in meters of the parts of a geodesic edge
+// that are integrated as parabolas in lon/lat.
+const areaStep = 10000
+
+// Area returns the area in square meters of the geometry on the ellipsoid,
+// the edges of the rings are geodesics. Holes are subtracted from their polygon.
+func (e Ellipsoid) Area(g geo.Geometry) float64 {
+	switch g := g.(type) {
+	case nil, geo.Point, geo.MultiPoint, geo.LineString, geo.MultiLineString:
+		return 0
+	case geo.Ring:
+		return math.Abs(e.SignedArea(g))
+	case geo.Polygon:
+		return e.polygonArea(g)
+	case geo.MultiPolygon:
+		var sum float64
+		for _, p := range g {
+			sum += e.polygonArea(p)
+		}
+
+		return sum
+	case geo.Collection:
+		var sum float64
+		for _, c := range g {
+			sum += e.Area(c)
+		}
+
+		return sum
+	case geo.Bound:
+		return e.Area(g.ToRing())
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+// SignedArea returns the signed area in square meters of the ring, positive
+// if it is counter-clockwise. Rings around a pole enclose the smaller side.
+// The ring is implicitly closed.
+func (e Ellipsoid) SignedArea(r geo.Ring) float64 {
+	if len(r) < 3 {
+		return 0
+	}
+
+	var area, turn float64
+	for i := range r {
+		p1, p2 := r[i], r[(i+1)%len(r)]
+		a, t := e.edgeArea(p1, p2)
+		area += a
+		turn += t
+	}
+
+	// the integral ends up on the other side of the pole
+	// if the ring goes around it
+	if math.Abs(turn) > math.Pi {
+		area += 2 * math.Pi * e.zoneArea(math.Pi/2)
+	}
+
+	total := 4 * math.Pi * e.zoneArea(math.Pi/2)
+	switch {
+	case area > total/2:
+		area -= total
+	case area <= -total/2:
+		area += total
+	}
+
+	return area
+}
+
+// edgeArea returns minus the integral of the zone area over the longitude along
+// the geodesic between the points, and the change in longitude in radians.
+func (e Ellipsoid) edgeArea(p1, p2 geo.Point) (area, turn float64) {
+	if p1 == p2 {
+		return 0, 0
+	}
+
+	distance, azimuth, _ := e.Inverse(p1, p2)
+	n := int(math.Ceil(distance / areaStep))
+	at := func(f float64) geo.Point {
+		p, _ := e.Direct(p1, azimuth, distance*f)
+		return p
+	}
+
+	prev := p1
+	for i := 1; i <= n; i++ {
+		next := p2
+		if i < n {
+			next = at(float64(i) / float64(n))
+		}
+
+		mid := at((float64(i) - 0.5) / float64(n))
+
+		// Simpson's rule along the parabola through the start, middle and end of the part
+		lat1, latm, lat2 := deg2rad(prev[1]), deg2rad(mid[1]), deg2rad(next[1])
+		lm := deg2rad(geo.WrapLon(mid[0] - prev[0]))
+		l2 := deg2rad(geo.WrapLon(next[0] - prev[0]))
+		area -= (e.zoneArea(lat1)*(4*lm-l2) + 4*e.zoneArea(latm)*l2 + e.zoneArea(lat2)*(3*l2-4*lm)) / 6
+		turn += l2
+		prev = next
+	}
+
+	return area, turn
+}
+
+// zoneArea returns the area between the equator and the latitude
+// in radians per radian of longitude.
+func (e Ellipsoid) zoneArea(lat float64) float64 {
+	b := e.B()
+	sin := math.Sin(lat)
+	e2 := e.E2()
+	if e2 == 0 {
+		return b * b * sin
+	}
+
+	ec := math.Sqrt(e2)
+	return b * b / 2 * (sin/(1-e2*sin*sin) + math.Atanh(ec*sin)/ec)
+}
+
+func (e Ellipsoid) polygonArea(p geo.Polygon) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+
+	sum := math.Abs(e.SignedArea(p[0]))
+	for i := 1; i < len(p); i++ {
+		sum -= math.Abs(e.SignedArea(p[i]))
+	}
+
+	return sum
+}
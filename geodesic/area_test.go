@@ -0,0 +1,110 @@
+package geodesic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+// the surface area of WGS84
+const wgs84Area = 510065621724088.5
+
+func TestArea(t *testing.T) {
+	cases := []struct {
+		name      string
+		geom      geo.Geometry
+		area      float64
+		tolerance float64
+	}{
+		{
+			name:      "northern hemisphere",
+			geom:      geo.Ring{{0, 0}, {90, 0}, {180, 0}, {-90, 0}, {0, 0}},
+			area:      wgs84Area / 2,
+			tolerance: 1,
+		},
+		{
+			name:      "southern hemisphere",
+			geom:      geo.Ring{{0, 0}, {-90, 0}, {180, 0}, {90, 0}, {0, 0}},
+			area:      wgs84Area / 2,
+			tolerance: 1,
+		},
+		{
+			name: "polygon with hole",
+			geom: geo.Polygon{
+				{{0, 0}, {90, 0}, {180, 0}, {-90, 0}, {0, 0}},
+				{{0, 0}, {-90, 0}, {180, 0}, {90, 0}, {0, 0}},
+			},
+			area:      0,
+			tolerance: 1,
+		},
+		{
+			name: "line",
+			geom: geo.LineString{{0, 0}, {1, 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if a := Area(tc.geom); math.Abs(a-tc.area) > tc.tolerance {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+		})
+	}
+}
+
+func TestArea_pole(t *testing.T) {
+	// a cap around the north pole in both directions
+	east := geo.Ring{{0, 80}, {90, 80}, {180, 80}, {-90, 80}, {0, 80}}
+	west := geo.Ring{{0, 80}, {-90, 80}, {180, 80}, {90, 80}, {0, 80}}
+
+	a := Area(east)
+	if a <= 0 || a > wgs84Area/20 {
+		t.Fatalf("incorrect area: %v", a)
+	}
+
+	if w := Area(west); math.Abs(w-a) > 1 {
+		t.Errorf("incorrect area: %v != %v", w, a)
+	}
+
+	if s := WGS84.SignedArea(east); s <= 0 {
+		t.Errorf("incorrect sign: %v", s)
+	}
+
+	if s := WGS84.SignedArea(west); s >= 0 {
+		t.Errorf("incorrect sign: %v", s)
+	}
+}
+
+func TestArea_squareDegree(t *testing.T) {
+	// a geodesic square, checked against the integral with smaller steps
+	r := geo.Ring{{10, 40}, {11, 40}, {11, 41}, {10, 41}, {10, 40}}
+	a := Area(r)
+
+	var fine float64
+	for i := 0; i < len(r)-1; i++ {
+		d, az, _ := Inverse(r[i], r[i+1])
+		prev := r[i]
+		for k := 1; k <= 10000; k++ {
+			next := r[i+1]
+			if k < 10000 {
+				next, _ = Direct(r[i], az, d*float64(k)/10000)
+			}
+
+			lat1, lat2 := deg2rad(prev[1]), deg2rad(next[1])
+			fine -= (WGS84.zoneArea(lat1) + WGS84.zoneArea(lat2)) / 2 * deg2rad(next[0]-prev[0])
+			prev = next
+		}
+	}
+
+	if math.Abs(a-fine) > 0.01 {
+		t.Errorf("incorrect area: %v != %v", a, fine)
+	}
+}
+
+func TestArea_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Area(g)
+		Length(g)
+	}
+}
@@ -0,0 +1,116 @@
+// Package geodesic computes distances, bearings, lengths and areas on an ellipsoid
+// such as WGS84, for lon/lat data where the spherical geometries package is not accurate enough.
+package geodesic
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/length"
+)
+
+// Ellipsoid is an ellipsoid of revolution defined by its equatorial radius
+// in meters and its flattening.
+type Ellipsoid struct {
+	A float64 // the semi-major axis in meters
+	F float64 // the flattening, (a-b)/a
+}
+
+var (
+	// WGS84 is the ellipsoid of the World Geodetic System 1984 used by GPS.
+	WGS84 = Ellipsoid{A: 6378137, F: 1 / 298.257223563}
+
+	// GRS80 is the ellipsoid of the Geodetic Reference System 1980, used by ETRS89 and NAD83.
+	GRS80 = Ellipsoid{A: 6378137, F: 1 / 298.257222101}
+)
+
+// NewEllipsoid creates an ellipsoid from the semi-major axis
+// in meters and the inverse flattening, e.g. 298.257223563.
+// An inverse flattening of 0 is a sphere.
+func NewEllipsoid(a, inverseFlattening float64) Ellipsoid {
+	if inverseFlattening == 0 {
+		return Ellipsoid{A: a}
+	}
+
+	return Ellipsoid{A: a, F: 1 / inverseFlattening}
+}
+
+// B returns the semi-minor axis in meters.
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F)
+}
+
+// E2 returns the square of the first eccentricity.
+func (e Ellipsoid) E2() float64 {
+	return e.F * (2 - e.F)
+}
+
+// Distance returns the length in meters of the geodesic, the shortest path on the ellipsoid,
+// between the points. It can be used as a geo.DistanceFunc.
+func (e Ellipsoid) Distance(p1, p2 geo.Point) float64 {
+	d, _, _ := e.Inverse(p1, p2)
+	return d
+}
+
+// Bearing returns the azimuth in degrees, clockwise from north in [-180, 180],
+// of the geodesic from the first point towards the second.
+func (e Ellipsoid) Bearing(from, to geo.Point) float64 {
+	_, azimuth, _ := e.Inverse(from, to)
+	return azimuth
+}
+
+// PointAtBearingAndDistance returns the point at the distance in meters
+// along the geodesic starting at the azimuth in degrees from the point.
+func (e Ellipsoid) PointAtBearingAndDistance(p geo.Point, bearing, distance float64) geo.Point {
+	q, _ := e.Direct(p, bearing, distance)
+	return q
+}
+
+// Length returns the length in meters of the boundary of the geometry along geodesics.
+func (e Ellipsoid) Length(g geo.Geometry) float64 {
+	return length.Length(g, e.Distance)
+}
+
+// Distance returns the geodesic distance in meters between the points on WGS84.
+func Distance(p1, p2 geo.Point) float64 {
+	return WGS84.Distance(p1, p2)
+}
+
+// Bearing returns the azimuth in degrees of the geodesic from the first point towards the second on WGS84.
+func Bearing(from, to geo.Point) float64 {
+	return WGS84.Bearing(from, to)
+}
+
+// PointAtBearingAndDistance returns the point at the distance in meters
+// along the geodesic starting at the azimuth in degrees from the point on WGS84.
+func PointAtBearingAndDistance(p geo.Point, bearing, distance float64) geo.Point {
+	return WGS84.PointAtBearingAndDistance(p, bearing, distance)
+}
+
+// Inverse solves the inverse geodesic problem on WGS84, see Ellipsoid.Inverse.
+func Inverse(p1, p2 geo.Point) (distance, azimuth1, azimuth2 float64) {
+	return WGS84.Inverse(p1, p2)
+}
+
+// Direct solves the direct geodesic problem on WGS84, see Ellipsoid.Direct.
+func Direct(p geo.Point, azimuth, distance float64) (geo.Point, float64) {
+	return WGS84.Direct(p, azimuth, distance)
+}
+
+// Length returns the length in meters of the boundary of the geometry along geodesics on WGS84.
+func Length(g geo.Geometry) float64 {
+	return WGS84.Length(g)
+}
+
+// Area returns the area in square meters of the geometry on WGS84.
+func Area(g geo.Geometry) float64 {
+	return WGS84.Area(g)
+}
+
+func deg2rad(d float64) float64 {
+	return d * math.Pi / 180.0
+}
+
+func rad2deg(r float64) float64 {
+	return 180.0 * r / math.Pi
+}
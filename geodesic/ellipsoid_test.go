@@ -0,0 +1,190 @@
+package geodesic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func dms(d, m, s float64) float64 {
+	if d < 0 {
+		return d - m/60 - s/3600
+	}
+
+	return d + m/60 + s/3600
+}
+
+func TestInverse(t *testing.T) {
+	cases := []struct {
+		name                string
+		p1, p2              geo.Point
+		distance            float64
+		azimuth1, azimuth2  float64
+		distanceTol, aziTol float64
+	}{
+		{
+			// Vincenty's test, Flinders Peak to Buninyong on the ANS ellipsoid,
+			// here on WGS84 with the same result to the millimeter
+			name:        "flinders peak",
+			p1:          geo.Point{dms(144, 25, 29.52440), dms(-37, 57, 3.72030)},
+			p2:          geo.Point{dms(143, 55, 35.38390), dms(-37, 39, 10.15610)},
+			distance:    54972.271,
+			azimuth1:    dms(306, 52, 5.37) - 360,
+			azimuth2:    dms(127, 10, 25.07) - 180,
+			distanceTol: 0.001,
+			aziTol:      1e-5,
+		},
+		{
+			// from the GeographicLib documentation
+			name:        "wellington to salamanca",
+			p1:          geo.Point{174.81, -41.32},
+			p2:          geo.Point{-5.50, 40.96},
+			distance:    19959679.267353,
+			azimuth1:    161.067669986160,
+			azimuth2:    18.825195123247,
+			distanceTol: 0.001,
+			aziTol:      1e-8,
+		},
+		{
+			name:        "along the equator",
+			p1:          geo.Point{0, 0},
+			p2:          geo.Point{90, 0},
+			distance:    WGS84.A * math.Pi / 2,
+			azimuth1:    90,
+			azimuth2:    90,
+			distanceTol: 1e-5,
+			aziTol:      1e-12,
+		},
+		{
+			name:        "along a meridian",
+			p1:          geo.Point{10, 0},
+			p2:          geo.Point{10, 90},
+			distance:    10001965.729,
+			azimuth1:    0,
+			azimuth2:    0,
+			distanceTol: 0.001,
+			aziTol:      1e-9,
+		},
+		{
+			// the geodesic goes over a pole
+			name:        "antipodal on the equator",
+			p1:          geo.Point{0, 0},
+			p2:          geo.Point{180, 0},
+			distance:    20003931.4586,
+			azimuth1:    0,
+			azimuth2:    180,
+			distanceTol: 0.001,
+			aziTol:      1e-9,
+		},
+		{
+			name:        "pole to pole",
+			p1:          geo.Point{0, -90},
+			p2:          geo.Point{0, 90},
+			distance:    20003931.4586,
+			azimuth1:    0,
+			azimuth2:    0,
+			distanceTol: 0.001,
+			aziTol:      1e-9,
+		},
+		{
+			// from the GeographicLib documentation, Vincenty's formulae do not converge
+			name:        "nearly antipodal",
+			p1:          geo.Point{0, 0},
+			p2:          geo.Point{179.5, 0.5},
+			distance:    19936288.579,
+			azimuth1:    25.67187286,
+			azimuth2:    154.32708548,
+			distanceTol: 0.001,
+			aziTol:      1e-7,
+		},
+		{
+			// from Karney (2013) "Algorithms for geodesics"
+			name:        "nearly antipodal across the equator",
+			p1:          geo.Point{0, -30},
+			p2:          geo.Point{179.8, 29.9},
+			distance:    19989832.827610,
+			azimuth1:    161.890524736,
+			azimuth2:    18.090737246,
+			distanceTol: 0.001,
+			aziTol:      1e-7,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, a1, a2 := Inverse(tc.p1, tc.p2)
+			if math.Abs(d-tc.distance) > tc.distanceTol {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if math.Abs(geo.WrapLon(a1-tc.azimuth1)) > tc.aziTol || math.Abs(geo.WrapLon(a2-tc.azimuth2)) > tc.aziTol {
+				t.Errorf("incorrect azimuths: %v %v", a1, a2)
+			}
+
+			// the direct problem takes us back
+			p, a := Direct(tc.p1, a1, d)
+			if math.Abs(p[0]-geo.WrapLon(tc.p2[0])) > 1e-8 && tc.p2[1] != 90 || math.Abs(p[1]-tc.p2[1]) > 1e-8 {
+				t.Errorf("incorrect direct point: %v != %v", p, tc.p2)
+			}
+
+			if math.Abs(geo.WrapLon(a-a2)) > 1e-7 && tc.p2[1] != 90 {
+				t.Errorf("incorrect direct azimuth: %v != %v", a, a2)
+			}
+		})
+	}
+}
+
+func TestInverse_nearlyAntipodal(t *testing.T) {
+	cases := [][2]geo.Point{
+		{{0, 0}, {179.7, 0.5}},
+		{{10, 0.5}, {-170.3, 0}},
+		{{0, 10}, {179.5, -10.1}},
+		{{-30, 45}, {150.2, -44.9}},
+		{{0, 0}, {179.99, 0}},
+	}
+
+	for _, tc := range cases {
+		d, a1, a2 := Inverse(tc[0], tc[1])
+		if r, _, _ := Inverse(tc[1], tc[0]); math.Abs(r-d) > 1e-6 {
+			t.Errorf("%v: should be symmetric: %v != %v", tc, r, d)
+		}
+
+		// no geodesic is longer than half a meridian
+		if d < 19900000 || d > 20003931.4586 {
+			t.Errorf("%v: incorrect distance: %v", tc, d)
+		}
+
+		p, a := Direct(tc[0], a1, d)
+		if math.Abs(geo.WrapLon(p[0]-tc[1][0])) > 1e-8 || math.Abs(p[1]-tc[1][1]) > 1e-8 {
+			t.Errorf("%v: incorrect direct point: %v", tc, p)
+		}
+
+		if math.Abs(geo.WrapLon(a-a2)) > 1e-7 {
+			t.Errorf("%v: incorrect direct azimuth: %v != %v", tc, a, a2)
+		}
+	}
+}
+
+func TestInverse_samePoint(t *testing.T) {
+	if d, a1, a2 := Inverse(geo.Point{1, 2}, geo.Point{1, 2}); d != 0 || a1 != 0 || a2 != 0 {
+		t.Errorf("incorrect result: %v %v %v", d, a1, a2)
+	}
+}
+
+func TestLength(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {45, 0}, {90, 0}}
+	if l := Length(ls); math.Abs(l-WGS84.A*math.Pi/2) > 1e-5 {
+		t.Errorf("incorrect length: %v", l)
+	}
+}
+
+func TestNewEllipsoid(t *testing.T) {
+	if e := NewEllipsoid(6378137, 298.257223563); e.A != WGS84.A || math.Abs(e.F-WGS84.F) > 1e-18 {
+		t.Errorf("incorrect ellipsoid: %v", e)
+	}
+
+	if b := WGS84.B(); math.Abs(b-6356752.314245) > 1e-6 {
+		t.Errorf("incorrect semi-minor axis: %v", b)
+	}
+}
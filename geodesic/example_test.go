@@ -0,0 +1,28 @@
+package geodesic_test
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+func ExampleInverse() {
+	wellington := geo.Point{174.81, -41.32}
+	salamanca := geo.Point{-5.50, 40.96}
+
+	d, azimuth1, azimuth2 := geodesic.Inverse(wellington, salamanca)
+	fmt.Printf("%.3f m %.6f %.6f\n", d, azimuth1, azimuth2)
+	// Output:
+	// 19959679.267 m 161.067670 18.825195
+}
+
+func ExampleEllipsoid_Area() {
+	// the northern hemisphere of the Clarke 1866 ellipsoid
+	clarke := geodesic.NewEllipsoid(6378206.4, 294.9786982)
+	r := geo.Ring{{0, 0}, {90, 0}, {180, 0}, {-90, 0}, {0, 0}}
+
+	fmt.Printf("%.0f km^2\n", clarke.Area(r)/1e6)
+	// Output:
+	// 255032015 km^2
+}
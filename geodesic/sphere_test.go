@@ -0,0 +1,60 @@
+package geodesic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+	"github.com/pchchv/geo/geometries"
+)
+
+// the geometries package imports project which uses this package,
+// so the comparisons with the sphere are external tests.
+
+func TestEllipsoid_sphere(t *testing.T) {
+	sphere := geodesic.NewEllipsoid(geo.EarthRadius, 0)
+	p1, p2 := geo.Point{-1.8444, 53.1506}, geo.Point{0.1406, 52.2047}
+
+	if d, h := sphere.Distance(p1, p2), geometries.DistanceHaversine(p1, p2); math.Abs(d-h) > 1e-6 {
+		t.Errorf("incorrect distance: %v != %v", d, h)
+	}
+
+	if b, h := sphere.Bearing(p1, p2), geometries.Bearing(p1, p2); math.Abs(b-h) > 1e-9 {
+		t.Errorf("incorrect bearing: %v != %v", b, h)
+	}
+
+	p := sphere.PointAtBearingAndDistance(p1, 60, 100000)
+	if h := geometries.PointAtBearingAndDistance(p1, 60, 100000); math.Abs(p[0]-h[0]) > 1e-9 || math.Abs(p[1]-h[1]) > 1e-9 {
+		t.Errorf("incorrect point: %v != %v", p, h)
+	}
+}
+
+func TestDistance_spherical(t *testing.T) {
+	// the ellipsoid is up to 0.5% off the sphere
+	p1, p2 := geo.Point{0, 0}, geo.Point{0, 45}
+	d, h := geodesic.Distance(p1, p2), geometries.DistanceHaversine(p1, p2)
+	if math.Abs(d-h)/d < 0.001 || math.Abs(d-h)/d > 0.005 {
+		t.Errorf("unexpected difference: %v %v", d, h)
+	}
+}
+
+func TestArea_sphere(t *testing.T) {
+	sphere := geodesic.NewEllipsoid(geo.EarthRadius, 0)
+	poly := geo.Polygon{{
+		{-122.4163816, 37.7792782},
+		{-122.4162786, 37.7787626},
+		{-122.4151027, 37.7789118},
+		{-122.4152143, 37.7794274},
+		{-122.4163816, 37.7792782},
+	}}
+
+	if a, s := sphere.Area(poly), geometries.Area(poly); math.Abs(a-s) > 0.01 {
+		t.Errorf("incorrect area: %v != %v", a, s)
+	}
+
+	// the ellipsoid is a bit smaller at this latitude
+	if a, s := geodesic.Area(poly), geometries.Area(poly); math.Abs(a-s)/s > 0.01 {
+		t.Errorf("incorrect area: %v != %v", a, s)
+	}
+}
@@ -0,0 +1,260 @@
+package geodesic
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// maxIterations bounds the iterations of Vincenty's formulae,
+// they converge within a few iterations except for nearly antipodal points.
+const maxIterations = 200
+
+// Inverse solves the inverse geodesic problem with Vincenty's formulae, it returns the
+// length in meters of the geodesic between the points and its azimuths in degrees,
+// clockwise from north in [-180, 180], at the first and at the second point.
+// The formulae are accurate to less than a millimeter. For nearly antipodal points,
+// where they do not converge, the azimuth at the first point is found by bisection
+// as in Karney (2013) "Algorithms for geodesics".
+func (e Ellipsoid) Inverse(p1, p2 geo.Point) (distance, azimuth1, azimuth2 float64) {
+	if p1 == p2 {
+		return 0, 0, 0
+	}
+
+	a, b, f := e.A, e.B(), e.F
+	l := deg2rad(geo.WrapLon(p2[0] - p1[0]))
+	sinU1, cosU1 := reducedLatitude(deg2rad(p1[1]), f)
+	sinU2, cosU2 := reducedLatitude(deg2rad(p2[1]), f)
+
+	var (
+		sinLambda, cosLambda      float64
+		sinSigma, cosSigma, sigma float64
+		cosSqAlpha, cos2SigmaM    float64
+	)
+
+	lambda := l
+	converged := false
+	for i := 0; i < maxIterations; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		x := cosU1*sinU2 - sinU1*cosU2*cosLambda
+		sinSigma = math.Hypot(cosU2*sinLambda, x)
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		if sinSigma == 0 {
+			if cosSigma < 0 {
+				// antipodal points, e.g. the poles
+				return e.inverseAntipodal(p1, p2)
+			}
+
+			// coincident points
+			return 0, 0, 0
+		}
+
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+
+		cos2SigmaM = 0 // on the equator
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+
+		c := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		prev := lambda
+		lambda = l + (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda) > math.Pi {
+			break
+		}
+
+		if math.Abs(lambda-prev) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		return e.inverseAntipodal(p1, p2)
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	bigA, bigB := vincentyAB(uSq)
+	deltaSigma := vincentyDeltaSigma(bigB, sinSigma, cosSigma, cos2SigmaM)
+	distance = b * bigA * (sigma - deltaSigma)
+
+	sinLambda, cosLambda = math.Sincos(lambda)
+	azimuth1 = rad2deg(math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda))
+	azimuth2 = rad2deg(math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda))
+
+	return distance, azimuth1, azimuth2
+}
+
+// Direct solves the direct geodesic problem with Vincenty's formulae, it returns the
+// point at the distance in meters along the geodesic that starts at the point with
+// the azimuth in degrees, and the azimuth of the geodesic at that point.
+func (e Ellipsoid) Direct(p geo.Point, azimuth, distance float64) (geo.Point, float64) {
+	a, b, f := e.A, e.B(), e.F
+	sinAlpha1, cosAlpha1 := math.Sincos(deg2rad(azimuth))
+	sinU1, cosU1 := reducedLatitude(deg2rad(p[1]), f)
+
+	sigma1 := math.Atan2(sinU1, cosU1*cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	bigA, bigB := vincentyAB(uSq)
+
+	var sinSigma, cosSigma, cos2SigmaM float64
+	sigma := distance / (b * bigA)
+	for i := 0; i < maxIterations; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		prev := sigma
+		sigma = distance/(b*bigA) + vincentyDeltaSigma(bigB, sinSigma, cosSigma, cos2SigmaM)
+		if math.Abs(sigma-prev) < 1e-12 {
+			break
+		}
+	}
+
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	lat := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	c := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+	l := lambda - (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	q := geo.Point{geo.WrapLon(p[0] + rad2deg(l)), rad2deg(lat)}
+	return q, rad2deg(math.Atan2(sinAlpha, -x))
+}
+
+// inverseAntipodal solves the inverse problem for nearly antipodal points.
+// The points are swapped and mirrored so that the first point is south of the equator
+// and no closer to it than the second and the second is to the east, then the azimuth
+// at the first point is in [0, 180] and the longitude difference at the latitude of the
+// second point increases with it, so it can be found by bisection.
+func (e Ellipsoid) inverseAntipodal(p1, p2 geo.Point) (distance, azimuth1, azimuth2 float64) {
+	lat1, lat2 := p1[1], p2[1]
+	lon12 := geo.WrapLon(p2[0] - p1[0])
+
+	lonSign := 1.0
+	if lon12 < 0 {
+		lonSign, lon12 = -1, -lon12
+	}
+
+	swapSign := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapSign, lonSign = -1, -lonSign
+		lat1, lat2 = lat2, lat1
+	}
+
+	latSign := 1.0
+	if lat1 >= 0 {
+		latSign, lat1, lat2 = -1, -lat1, -lat2
+	}
+
+	arc := geodesicArc{e: e}
+	arc.sinBeta1, arc.cosBeta1 = reducedLatitude(deg2rad(lat1), e.F)
+	arc.sinBeta2, arc.cosBeta2 = reducedLatitude(deg2rad(lat2), e.F)
+
+	// from a pole the geodesics are meridians and the azimuth is the longitude difference
+	alpha1 := deg2rad(lon12)
+	if lat1 != -90 {
+		lo, hi := 0.0, math.Pi
+		for {
+			alpha1 = (lo + hi) / 2
+			if alpha1 <= lo || alpha1 >= hi {
+				break
+			}
+
+			if arc.solve(alpha1); arc.lambda12 < deg2rad(lon12) {
+				lo = alpha1
+			} else {
+				hi = alpha1
+			}
+		}
+	}
+
+	arc.solve(alpha1)
+	sinAlpha1, cosAlpha1 := math.Sincos(alpha1)
+	sinAlpha2, cosAlpha2 := arc.sinAlpha2, arc.cosAlpha2
+	if swapSign < 0 {
+		sinAlpha1, sinAlpha2 = sinAlpha2, sinAlpha1
+		cosAlpha1, cosAlpha2 = cosAlpha2, cosAlpha1
+	}
+
+	azimuth1 = rad2deg(math.Atan2(swapSign*lonSign*sinAlpha1, swapSign*latSign*cosAlpha1))
+	azimuth2 = rad2deg(math.Atan2(swapSign*lonSign*sinAlpha2, swapSign*latSign*cosAlpha2))
+	return arc.distance, azimuth1, azimuth2
+}
+
+// geodesicArc is the geodesic from the reduced latitude beta1 with an azimuth
+// to where it first reaches the reduced latitude beta2 going north.
+type geodesicArc struct {
+	e                  Ellipsoid
+	sinBeta1, cosBeta1 float64
+	sinBeta2, cosBeta2 float64
+
+	// the results of solve
+	lambda12             float64
+	distance             float64
+	sinAlpha2, cosAlpha2 float64
+}
+
+// solve computes the longitude difference, the distance and the
+// azimuth at the end of the arc that starts with the azimuth.
+func (g *geodesicArc) solve(alpha1 float64) {
+	a, b, f := g.e.A, g.e.B(), g.e.F
+	sinAlpha1, cosAlpha1 := math.Sincos(alpha1)
+
+	// the azimuth at the equator
+	sinAlpha0 := sinAlpha1 * g.cosBeta1
+	cosSqAlpha0 := 1 - sinAlpha0*sinAlpha0
+
+	g.sinAlpha2, g.cosAlpha2 = 0, 1
+	if g.cosBeta2 != 0 {
+		g.sinAlpha2 = sinAlpha0 / g.cosBeta2
+		g.cosAlpha2 = math.Sqrt(cosAlpha1*g.cosBeta1*cosAlpha1*g.cosBeta1+
+			(g.cosBeta2-g.cosBeta1)*(g.cosBeta2+g.cosBeta1)) / g.cosBeta2
+	}
+
+	// the arc lengths and longitudes on the auxiliary sphere from the equator crossing
+	sinSigma1, cosSigma1 := g.sinBeta1, cosAlpha1*g.cosBeta1
+	sinSigma2, cosSigma2 := g.sinBeta2, g.cosAlpha2*g.cosBeta2
+	sinOmega1, cosOmega1 := sinAlpha0*g.sinBeta1, cosAlpha1*g.cosBeta1
+	sinOmega2, cosOmega2 := sinAlpha0*g.sinBeta2, g.cosAlpha2*g.cosBeta2
+
+	sigma := math.Atan2(math.Max(0, cosSigma1*sinSigma2-sinSigma1*cosSigma2), cosSigma1*cosSigma2+sinSigma1*sinSigma2)
+	omega := math.Atan2(math.Max(0, cosOmega1*sinOmega2-sinOmega1*cosOmega2), cosOmega1*cosOmega2+sinOmega1*sinOmega2)
+	sinSigma, cosSigma := math.Sincos(sigma)
+	cos2SigmaM := math.Cos(2*math.Atan2(sinSigma1, cosSigma1) + sigma)
+
+	c := f / 16 * cosSqAlpha0 * (4 + f*(4-3*cosSqAlpha0))
+	g.lambda12 = omega - (1-c)*f*sinAlpha0*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	uSq := cosSqAlpha0 * (a*a - b*b) / (b * b)
+	bigA, bigB := vincentyAB(uSq)
+	g.distance = b * bigA * (sigma - vincentyDeltaSigma(bigB, sinSigma, cosSigma, cos2SigmaM))
+}
+
+// reducedLatitude returns the sine and cosine of the reduced latitude,
+// the latitude on the auxiliary sphere.
+func reducedLatitude(lat, f float64) (sinU, cosU float64) {
+	tanU := (1 - f) * math.Tan(lat)
+	cosU = 1 / math.Sqrt(1+tanU*tanU)
+	sinU = tanU * cosU
+	if math.IsInf(tanU, 0) {
+		return math.Copysign(1, tanU), 0
+	}
+
+	return sinU, cosU
+}
+
+func vincentyAB(uSq float64) (float64, float64) {
+	a := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	b := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	return a, b
+}
+
+func vincentyDeltaSigma(b, sinSigma, cosSigma, cos2SigmaM float64) float64 {
+	return b * sinSigma * (cos2SigmaM + b/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		b/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+}
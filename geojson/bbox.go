@@ -15,6 +15,15 @@ func NewBBox(b geo.Bound) BBox {
 	}
 }
 
+// NewBBoxWrapped creates a bbox from a bound that may cross the antimeridian,
+// the west is then larger than the east as in RFC 7946, section 5.2.
+func NewBBoxWrapped(b geo.BoundWrapped) BBox {
+	return []float64{
+		b.Min[0], b.Min[1],
+		b.Max[0], b.Max[1],
+	}
+}
+
 // Valid checks if the bbox is present and has at least 4 elements.
 func (bb BBox) Valid() bool {
 	if bb == nil {
@@ -25,13 +34,25 @@ func (bb BBox) Valid() bool {
 }
 
 // Bound returns the geo.Bound for the BBox.
+// A bbox that crosses the antimeridian, west larger than east,
+// returns the bound of all longitudes, use BoundWrapped to keep it narrow.
 func (bb BBox) Bound() geo.Bound {
 	if !bb.Valid() {
 		return geo.Bound{}
 	}
 
+	return bb.BoundWrapped().Bound()
+}
+
+// BoundWrapped returns the geo.BoundWrapped for the BBox,
+// it crosses the antimeridian if the west is larger than the east.
+func (bb BBox) BoundWrapped() geo.BoundWrapped {
+	if !bb.Valid() {
+		return geo.BoundWrapped{}
+	}
+
 	mid := len(bb) / 2
-	return geo.Bound{
+	return geo.BoundWrapped{
 		Min: geo.Point{bb[0], bb[1]},
 		Max: geo.Point{bb[mid], bb[mid+1]},
 	}
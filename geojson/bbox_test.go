@@ -70,6 +70,11 @@ func TestBBoxBound(t *testing.T) {
 			bbox:   []float64{1, 2, 3, 4, 5, 6},
 			result: geo.Bound{Min: geo.Point{1, 2}, Max: geo.Point{4, 5}},
 		},
+		{
+			name:   "all longitudes if crossing the antimeridian",
+			bbox:   []float64{177, -21, -178, -12},
+			result: geo.Bound{Min: geo.Point{-180, -21}, Max: geo.Point{180, -12}},
+		},
 	}
 
 	for _, tc := range cases {
@@ -90,3 +95,19 @@ func TestBBox(t *testing.T) {
 		t.Errorf("incorrect result: %v != %v", bbox, expected)
 	}
 }
+
+func TestBBoxBoundWrapped(t *testing.T) {
+	b := geo.BoundWrapped{Min: geo.Point{177, -21}, Max: geo.Point{-178, -12}}
+	bbox := NewBBoxWrapped(b)
+	if !reflect.DeepEqual(bbox, BBox{177, -21, -178, -12}) {
+		t.Errorf("incorrect bbox: %v", bbox)
+	}
+
+	if v := bbox.BoundWrapped(); v != b {
+		t.Errorf("incorrect bound: %v", v)
+	}
+
+	if v := (BBox{1, 2, 3}).BoundWrapped(); v != (geo.BoundWrapped{}) {
+		t.Errorf("should be zero for invalid bbox: %v", v)
+	}
+}
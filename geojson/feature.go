@@ -17,6 +17,7 @@ type Feature struct {
 	BBox       BBox         `json:"bbox,omitempty"`
 	Geometry   geo.Geometry `json:"geometry"`
 	Properties Properties   `json:"properties"`
+	ZM         geo.ZM       `json:"-" bson:"-"` // Z and M values of the points of the geometry
 }
 
 // NewFeature creates and initializes a GeoJSON feature given the required attributes.
@@ -101,7 +102,7 @@ func newFeatureDoc(f *Feature) *featureDoc {
 		Type:       "Feature",
 		Properties: f.Properties,
 		BBox:       f.BBox,
-		Geometry:   NewGeometry(f.Geometry),
+		Geometry:   NewGeometryZM(f.Geometry, f.ZM),
 	}
 	if len(doc.Properties) == 0 {
 		doc.Properties = nil
@@ -115,12 +116,15 @@ func featureUnmarshalFinish(doc *featureDoc, f *Feature) error {
 		return fmt.Errorf("geojson: not a feature: type=%s", doc.Type)
 	}
 
-	var g geo.Geometry
+	var (
+		g  geo.Geometry
+		zm geo.ZM
+	)
 	if doc.Geometry != nil {
 		if doc.Geometry.Coordinates == nil && doc.Geometry.Geometries == nil {
 			return ErrInvalidGeometry
 		}
-		g = doc.Geometry.Geometry()
+		g, zm = doc.Geometry.GeometryZM()
 	}
 
 	*f = Feature{
@@ -129,6 +133,7 @@ func featureUnmarshalFinish(doc *featureDoc, f *Feature) error {
 		Properties: doc.Properties,
 		BBox:       doc.BBox,
 		Geometry:   g,
+		ZM:         zm,
 	}
 
 	return nil
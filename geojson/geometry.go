@@ -11,10 +11,14 @@ import (
 var ErrInvalidGeometry = errors.New("geojson: invalid geometry")
 
 // Geometry matches the structure of a GeoJSON Geometry.
+// ZM holds the Z and M values of the points, the third and fourth
+// element of the positions, and is not used for collections
+// whose members have their own values.
 type Geometry struct {
 	Type        string       `json:"type"`
 	Coordinates geo.Geometry `json:"coordinates,omitempty"`
 	Geometries  []*Geometry  `json:"geometries,omitempty"`
+	ZM          geo.ZM       `json:"-" bson:"-"`
 }
 
 // NewGeometry will create a Geometry object but
@@ -49,7 +53,11 @@ func (g *Geometry) MarshalJSON() ([]byte, error) {
 		return []byte(`null`), nil
 	}
 
-	ng := newGeometryMarshallDoc(g)
+	ng, err := newGeometryMarshallDoc(g)
+	if err != nil {
+		return nil, err
+	}
+
 	return marshalJSON(ng)
 }
 
@@ -58,7 +66,11 @@ func (g *Geometry) MarshalJSON() ([]byte, error) {
 // This function is used when the geometry is the
 // top level document to be marshalled.
 func (g *Geometry) MarshalBSON() ([]byte, error) {
-	ng := newGeometryMarshallDoc(g)
+	ng, err := newGeometryMarshallDoc(g)
+	if err != nil {
+		return nil, err
+	}
+
 	return bson.Marshal(ng)
 }
 
@@ -72,7 +84,11 @@ func (g *Geometry) MarshalBSONValue() (bsontype.Type, []byte, error) {
 		return bsontype.Type(0x0A), nil, nil
 	}
 
-	ng := newGeometryMarshallDoc(g)
+	ng, err := newGeometryMarshallDoc(g)
+	if err != nil {
+		return 0, nil, err
+	}
+
 	return bson.MarshalValue(ng)
 }
 
@@ -109,6 +125,19 @@ func (g *Geometry) UnmarshalJSON(data []byte) (err error) {
 		return
 	}
 
+	g.ZM = geo.ZM{}
+	if jg.Type != "GeometryCollection" && hasExtraOrdinates(jg.Coordinates) {
+		g.Coordinates, g.ZM, err = decodeZM(jg.Type, func(v interface{}) error {
+			return unmarshalJSON(jg.Coordinates, v)
+		})
+		if err != nil {
+			return
+		}
+
+		g.Type = g.Coordinates.GeoJSONType()
+		return nil
+	}
+
 	switch jg.Type {
 	case "Point":
 		p := geo.Point{}
@@ -163,6 +192,17 @@ func (g *Geometry) UnmarshalBSON(data []byte) (err error) {
 		return
 	}
 
+	g.ZM = geo.ZM{}
+	if bg.Type != "GeometryCollection" && bsonHasExtraOrdinates(bg.Coordinates) {
+		g.Coordinates, g.ZM, err = decodeZM(bg.Type, bg.Coordinates.Unmarshal)
+		if err != nil {
+			return
+		}
+
+		g.Type = g.Coordinates.GeoJSONType()
+		return nil
+	}
+
 	switch bg.Type {
 	case "Point":
 		p := geo.Point{}
@@ -211,9 +251,9 @@ func (g *Geometry) UnmarshalBSON(data []byte) (err error) {
 }
 
 type geometryMarshallDoc struct {
-	Type        string       `json:"type" bson:"type"`
-	Coordinates geo.Geometry `json:"coordinates,omitempty" bson:"coordinates,omitempty"`
-	Geometries  []*Geometry  `json:"geometries,omitempty" bson:"geometries,omitempty"`
+	Type        string      `json:"type" bson:"type"`
+	Coordinates interface{} `json:"coordinates,omitempty" bson:"coordinates,omitempty"`
+	Geometries  []*Geometry `json:"geometries,omitempty" bson:"geometries,omitempty"`
 }
 
 type bsonGeometry struct {
@@ -228,25 +268,36 @@ type jsonGeometry struct {
 	Geometries  []*Geometry      `json:"geometries,omitempty"`
 }
 
-func newGeometryMarshallDoc(g *Geometry) *geometryMarshallDoc {
+func newGeometryMarshallDoc(g *Geometry) (*geometryMarshallDoc, error) {
 	ng := &geometryMarshallDoc{}
-	switch g := g.Coordinates.(type) {
+	var coordinates geo.Geometry
+	switch c := g.Coordinates.(type) {
 	case geo.Ring:
-		ng.Coordinates = geo.Polygon{g}
+		coordinates = geo.Polygon{c}
 	case geo.Bound:
-		ng.Coordinates = g.ToPolygon()
+		coordinates = c.ToPolygon()
 	case geo.Collection:
-		ng.Geometries = make([]*Geometry, 0, len(g))
-		for _, c := range g {
-			ng.Geometries = append(ng.Geometries, NewGeometry(c))
+		if !g.ZM.Matches(c) {
+			return nil, ErrInvalidGeometry
 		}
-		ng.Type = g.GeoJSONType()
+
+		ng.Geometries = splitCollection(c, g.ZM)
+		ng.Type = c.GeoJSONType()
 	default:
-		ng.Coordinates = g
+		coordinates = c
 	}
 
-	if ng.Coordinates != nil {
-		ng.Type = ng.Coordinates.GeoJSONType()
+	if coordinates != nil {
+		ng.Type = coordinates.GeoJSONType()
+		ng.Coordinates = coordinates
+		if g.ZM.Layout != geo.XY {
+			positions, err := zmPositions(coordinates, g.ZM)
+			if err != nil {
+				return nil, err
+			}
+
+			ng.Coordinates = positions
+		}
 	}
 
 	if len(g.Geometries) > 0 {
@@ -254,5 +305,5 @@ func newGeometryMarshallDoc(g *Geometry) *geometryMarshallDoc {
 		ng.Type = geo.Collection{}.GeoJSONType()
 	}
 
-	return ng
+	return ng, nil
 }
@@ -0,0 +1,340 @@
+package geojson
+
+import (
+	"errors"
+
+	"github.com/pchchv/geo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ErrMeasureWithoutZ is returned when marshalling M values without Z values.
+// A GeoJSON position has the elevation as its third element,
+// so a measure can only be the fourth.
+var ErrMeasureWithoutZ = errors.New("geojson: m values without z values can not be encoded")
+
+// ErrMixedDimensions is returned when unmarshalling coordinates
+// whose positions do not all have the same number of elements.
+var ErrMixedDimensions = errors.New("geojson: positions have different dimensions")
+
+// NewGeometryZM will create a Geometry object, like NewGeometry,
+// with the Z and M values of the points of the geometry.
+// The values are written as the third and fourth element of the positions.
+// The values of a collection are split among its members.
+func NewGeometryZM(g geo.Geometry, zm geo.ZM) *Geometry {
+	c, ok := g.(geo.Collection)
+	if !ok || zm.Layout == geo.XY {
+		jg := NewGeometry(g)
+		jg.ZM = zm
+		return jg
+	}
+
+	if !zm.Matches(c) {
+		// marshalling will return the error
+		return &Geometry{Type: c.GeoJSONType(), Coordinates: c, ZM: zm}
+	}
+
+	return &Geometry{Type: c.GeoJSONType(), Geometries: splitCollection(c, zm)}
+}
+
+func splitCollection(c geo.Collection, zm geo.ZM) []*Geometry {
+	geometries := make([]*Geometry, 0, len(c))
+	start := 0
+	for _, m := range c {
+		end := start + geo.PointCount(m)
+		geometries = append(geometries, NewGeometryZM(m, zm.Slice(start, end)))
+		start = end
+	}
+
+	return geometries
+}
+
+// GeometryZM returns the geo.Geometry, like Geometry, and the Z and M values of its points.
+// The values of the members of a collection are joined, members
+// without one of the ordinates of the others get zeros.
+func (g *Geometry) GeometryZM() (geo.Geometry, geo.ZM) {
+	if g.Coordinates != nil {
+		return g.Coordinates, g.ZM
+	}
+
+	var z, m bool
+	members := make([]geo.ZM, 0, len(g.Geometries))
+	c := make(geo.Collection, 0, len(g.Geometries))
+	for _, geom := range g.Geometries {
+		mg, mzm := geom.GeometryZM()
+		c = append(c, mg)
+		members = append(members, mzm)
+		z = z || mzm.Layout.HasZ()
+		m = m || mzm.Layout.HasM()
+	}
+
+	zm := geo.NewZM(layout(z, m), geo.PointCount(c))
+	if zm.Layout == geo.XY {
+		return c, geo.ZM{}
+	}
+
+	start := 0
+	for i, mzm := range members {
+		n := geo.PointCount(c[i])
+		if mzm.Layout.HasZ() {
+			copy(zm.Z[start:start+n], mzm.Z)
+		}
+
+		if mzm.Layout.HasM() {
+			copy(zm.M[start:start+n], mzm.M)
+		}
+
+		start += n
+	}
+
+	return c, zm
+}
+
+func layout(z, m bool) geo.Layout {
+	switch {
+	case z && m:
+		return geo.XYZM
+	case z:
+		return geo.XYZ
+	case m:
+		return geo.XYM
+	}
+
+	return geo.XY
+}
+
+// position is a GeoJSON position with any number of elements.
+type position []float64
+
+// zmPositions returns the coordinates of the geometry with the Z and M
+// values of the points as the third and fourth element of the positions.
+func zmPositions(g geo.Geometry, zm geo.ZM) (interface{}, error) {
+	if zm.Layout == geo.XYM {
+		return nil, ErrMeasureWithoutZ
+	}
+
+	if !zm.Matches(g) {
+		return nil, ErrInvalidGeometry
+	}
+
+	i := 0
+	pos := func(p geo.Point) position {
+		v := position{p[0], p[1]}
+		if zm.Layout.HasZ() {
+			v = append(v, zm.Z[i])
+		}
+
+		if zm.Layout.HasM() {
+			v = append(v, zm.M[i])
+		}
+
+		i++
+		return v
+	}
+
+	line := func(ls geo.LineString) []position {
+		r := make([]position, 0, len(ls))
+		for _, p := range ls {
+			r = append(r, pos(p))
+		}
+
+		return r
+	}
+
+	polygon := func(p geo.Polygon) [][]position {
+		r := make([][]position, 0, len(p))
+		for _, ring := range p {
+			r = append(r, line(geo.LineString(ring)))
+		}
+
+		return r
+	}
+
+	switch g := g.(type) {
+	case geo.Point:
+		return pos(g), nil
+	case geo.MultiPoint:
+		return line(geo.LineString(g)), nil
+	case geo.LineString:
+		return line(g), nil
+	case geo.MultiLineString:
+		r := make([][]position, 0, len(g))
+		for _, ls := range g {
+			r = append(r, line(ls))
+		}
+
+		return r, nil
+	case geo.Polygon:
+		return polygon(g), nil
+	case geo.MultiPolygon:
+		r := make([][][]position, 0, len(g))
+		for _, p := range g {
+			r = append(r, polygon(p))
+		}
+
+		return r, nil
+	}
+
+	return nil, ErrInvalidGeometry
+}
+
+// hasExtraOrdinates returns true if a position in the
+// JSON coordinates has more than two elements.
+func hasExtraOrdinates(data []byte) bool {
+	inner, commas := false, 0
+	for _, c := range data {
+		switch c {
+		case '[':
+			inner, commas = true, 0
+		case ',':
+			if inner {
+				commas++
+			}
+		case ']':
+			if inner && commas >= 2 {
+				return true
+			}
+
+			inner = false
+		}
+	}
+
+	return false
+}
+
+// bsonHasExtraOrdinates returns true if a position in the
+// BSON coordinates has more than two elements.
+func bsonHasExtraOrdinates(v bson.RawValue) bool {
+	a, ok := v.ArrayOK()
+	if !ok {
+		return false
+	}
+
+	values, err := a.Values()
+	if err != nil {
+		return false
+	}
+
+	if len(values) > 2 && values[0].Type != bson.TypeArray {
+		return true
+	}
+
+	for _, e := range values {
+		if bsonHasExtraOrdinates(e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decodeZM decodes the coordinates of the type
+// keeping the extra elements of the positions.
+// All the positions must have the same number of elements.
+func decodeZM(typ string, unmarshal func(interface{}) error) (geo.Geometry, geo.ZM, error) {
+	var (
+		g         geo.Geometry
+		positions []position
+	)
+
+	line := func(ps []position) geo.LineString {
+		positions = append(positions, ps...)
+		ls := make(geo.LineString, 0, len(ps))
+		for _, p := range ps {
+			var point geo.Point
+			copy(point[:], p)
+			ls = append(ls, point)
+		}
+
+		return ls
+	}
+
+	polygon := func(rs [][]position) geo.Polygon {
+		p := make(geo.Polygon, 0, len(rs))
+		for _, r := range rs {
+			p = append(p, geo.Ring(line(r)))
+		}
+
+		return p
+	}
+
+	switch typ {
+	case "Point":
+		var p position
+		if err := unmarshal(&p); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		g = line([]position{p})[0]
+	case "MultiPoint":
+		var ps []position
+		if err := unmarshal(&ps); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		g = geo.MultiPoint(line(ps))
+	case "LineString":
+		var ps []position
+		if err := unmarshal(&ps); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		g = line(ps)
+	case "MultiLineString":
+		var lss [][]position
+		if err := unmarshal(&lss); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		mls := make(geo.MultiLineString, 0, len(lss))
+		for _, ls := range lss {
+			mls = append(mls, line(ls))
+		}
+
+		g = mls
+	case "Polygon":
+		var rs [][]position
+		if err := unmarshal(&rs); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		g = polygon(rs)
+	case "MultiPolygon":
+		var ps [][][]position
+		if err := unmarshal(&ps); err != nil {
+			return nil, geo.ZM{}, err
+		}
+
+		mp := make(geo.MultiPolygon, 0, len(ps))
+		for _, p := range ps {
+			mp = append(mp, polygon(p))
+		}
+
+		g = mp
+	default:
+		return nil, geo.ZM{}, ErrInvalidGeometry
+	}
+
+	size := 0
+	if len(positions) > 0 {
+		size = len(positions[0])
+	}
+
+	for _, p := range positions {
+		if len(p) != size {
+			return nil, geo.ZM{}, ErrMixedDimensions
+		}
+	}
+
+	zm := geo.NewZM(layout(size > 2, size > 3), len(positions))
+	for i, p := range positions {
+		if zm.Layout.HasZ() {
+			zm.Z[i] = p[2]
+		}
+
+		if zm.Layout.HasM() {
+			zm.M[i] = p[3]
+		}
+	}
+
+	return g, zm, nil
+}
@@ -0,0 +1,151 @@
+package geojson
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGeometryZM(t *testing.T) {
+	cases := []struct {
+		name string
+		geom geo.Geometry
+		zm   geo.ZM
+		json string
+	}{
+		{
+			name: "point",
+			geom: geo.Point{1, 2},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{3}},
+			json: `{"type":"Point","coordinates":[1,2,3]}`,
+		},
+		{
+			name: "line string",
+			geom: geo.LineString{{1, 2}, {3, 4}},
+			zm:   geo.ZM{Layout: geo.XYZM, Z: []float64{5, 6}, M: []float64{7, 8}},
+			json: `{"type":"LineString","coordinates":[[1,2,5,7],[3,4,6,8]]}`,
+		},
+		{
+			name: "multi polygon",
+			geom: geo.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{1, 2, 3, 1}},
+			json: `{"type":"MultiPolygon","coordinates":[[[[0,0,1],[1,0,2],[1,1,3],[0,0,1]]]]}`,
+		},
+		{
+			name: "collection",
+			geom: geo.Collection{geo.Point{1, 2}, geo.MultiPoint{{3, 4}}},
+			zm:   geo.ZM{Layout: geo.XYZ, Z: []float64{5, 6}},
+			json: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2,5]},{"type":"MultiPoint","coordinates":[[3,4,6]]}]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(NewGeometryZM(tc.geom, tc.zm))
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+
+			if string(data) != tc.json {
+				t.Errorf("incorrect json: %v != %v", string(data), tc.json)
+			}
+
+			g, err := UnmarshalGeometry(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			geom, zm := g.GeometryZM()
+			if !geo.Equal(geom, tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", geom, tc.geom)
+			}
+
+			if !reflect.DeepEqual(zm, tc.zm) {
+				t.Errorf("incorrect zm: %v != %v", zm, tc.zm)
+			}
+
+			// bson
+			data, err = bson.Marshal(NewGeometryZM(tc.geom, tc.zm))
+			if err != nil {
+				t.Fatalf("bson marshal error: %v", err)
+			}
+
+			g = &Geometry{}
+			if err := bson.Unmarshal(data, g); err != nil {
+				t.Fatalf("bson unmarshal error: %v", err)
+			}
+
+			geom, zm = g.GeometryZM()
+			if !geo.Equal(geom, tc.geom) {
+				t.Errorf("bson: incorrect geometry: %v != %v", geom, tc.geom)
+			}
+
+			if !reflect.DeepEqual(zm, tc.zm) {
+				t.Errorf("bson: incorrect zm: %v != %v", zm, tc.zm)
+			}
+		})
+	}
+}
+
+func TestGeometryZM_mixedPositions(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "missing z",
+			data: `{"type":"LineString","coordinates":[[1,2],[3,4,5]]}`,
+		},
+		{
+			name: "missing m",
+			data: `{"type":"Polygon","coordinates":[[[0,0,1,2],[1,0,1,2],[1,1,1],[0,0,1,2]]]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnmarshalGeometry([]byte(tc.data))
+			if err != ErrMixedDimensions {
+				t.Errorf("incorrect error: %v != %v", err, ErrMixedDimensions)
+			}
+		})
+	}
+}
+
+func TestGeometryZM_errors(t *testing.T) {
+	_, err := json.Marshal(NewGeometryZM(geo.Point{1, 2}, geo.ZM{Layout: geo.XYM, M: []float64{3}}))
+	if err == nil {
+		t.Errorf("should not encode m without z")
+	}
+
+	_, err = json.Marshal(NewGeometryZM(geo.LineString{{1, 2}, {3, 4}}, geo.NewZM(geo.XYZ, 1)))
+	if err == nil {
+		t.Errorf("should not encode too few values")
+	}
+}
+
+func TestFeatureZM(t *testing.T) {
+	f := NewFeature(geo.LineString{{1, 2}, {3, 4}})
+	f.ZM = geo.ZM{Layout: geo.XYZ, Z: []float64{100, 110}}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	nf, err := UnmarshalFeature(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !geo.Equal(nf.Geometry, f.Geometry) {
+		t.Errorf("incorrect geometry: %v != %v", nf.Geometry, f.Geometry)
+	}
+
+	if !reflect.DeepEqual(nf.ZM, f.ZM) {
+		t.Errorf("incorrect zm: %v != %v", nf.ZM, f.ZM)
+	}
+}
@@ -0,0 +1,79 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/antimeridian"
+	"github.com/pchchv/geo/planar"
+	"github.com/pchchv/geo/project"
+)
+
+// Buffer returns the area within the distance in meters of the lon/lat geometry,
+// see planar.Buffer for the options and the meaning of negative distances.
+// The buffer is computed in an azimuthal equidistant projection centered on the bound
+// of the geometry, so it is accurate for geometries up to a few hundred kilometers across.
+// If the buffer reaches a pole the projection is centered on it and the result is closed
+// over the pole like antimeridian.Polygon does. Other geometries crossing the antimeridian
+// are not supported.
+func Buffer(g geo.Geometry, meters float64, opts ...planar.BufferOption) geo.MultiPolygon {
+	if g == nil {
+		return planar.Buffer(nil, meters, opts...)
+	}
+
+	bound := g.Bound()
+	c := bound.Center()
+
+	// the buffer goes around a pole if it reaches it
+	reach := rad2deg(math.Max(meters, 0) / geo.EarthRadius)
+	polar := true
+	switch {
+	case bound.Max[1]+reach >= 90:
+		c[1] = 90
+	case bound.Min[1]-reach <= -90:
+		c[1] = -90
+	default:
+		polar = false
+	}
+
+	lon0, lat0 := deg2rad(c[0]), deg2rad(c[1])
+	sinLat0, cosLat0 := math.Sin(lat0), math.Cos(lat0)
+
+	forward := func(p geo.Point) geo.Point {
+		lat, dLon := deg2rad(p[1]), deg2rad(p[0])-lon0
+		sinLat, cosLat := math.Sin(lat), math.Cos(lat)
+		cosDLon := math.Cos(dLon)
+
+		cosC := sinLat0*sinLat + cosLat0*cosLat*cosDLon
+		k := 1.0
+		if angle := math.Acos(math.Max(-1, math.Min(1, cosC))); angle > 0 {
+			k = angle / math.Sin(angle)
+		}
+
+		return geo.Point{
+			geo.EarthRadius * k * cosLat * math.Sin(dLon),
+			geo.EarthRadius * k * (cosLat0*sinLat - sinLat0*cosLat*cosDLon),
+		}
+	}
+
+	inverse := func(p geo.Point) geo.Point {
+		rho := math.Hypot(p[0], p[1])
+		if rho == 0 {
+			return c
+		}
+
+		angle := rho / geo.EarthRadius
+		sinC, cosC := math.Sin(angle), math.Cos(angle)
+		lat := math.Asin(cosC*sinLat0 + p[1]*sinC*cosLat0/rho)
+		lon := lon0 + math.Atan2(p[0]*sinC, rho*cosLat0*cosC-p[1]*sinLat0*sinC)
+		return geo.Point{rad2deg(lon), rad2deg(lat)}
+	}
+
+	b := planar.Buffer(project.Geometry(geo.Clone(g), forward), meters, opts...)
+	b = project.MultiPolygon(b, inverse)
+	if polar {
+		return antimeridian.MultiPolygon(b)
+	}
+
+	return b
+}
@@ -0,0 +1,77 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestBuffer(t *testing.T) {
+	center := geo.Point{-122.4163816, 37.7792782}
+	b := Buffer(center, 1000)
+	if len(b) != 1 {
+		t.Fatalf("expected one polygon: %v", b)
+	}
+
+	for _, p := range b[0][0] {
+		if d := DistanceHaversine(center, p); math.Abs(d-1000) > 1e-6 {
+			t.Errorf("incorrect distance: %v", d)
+		}
+	}
+
+	// a 32-gon is a bit smaller than the circle
+	if a := Area(b); math.Abs(a/(math.Pi*1000*1000)-1) > 0.01 {
+		t.Errorf("incorrect area: %v", a)
+	}
+}
+
+func TestBuffer_polygon(t *testing.T) {
+	bound := NewBoundAroundPoint(geo.Point{10, 60}, 1000)
+	w, h := BoundWidth(bound), BoundHeight(bound)
+
+	b := Buffer(bound, 100, planar.Join(planar.JoinMitre))
+	if a := Area(b); math.Abs(a/((w+200)*(h+200))-1) > 0.01 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	b = Buffer(bound, -100)
+	if a := Area(b); math.Abs(a/((w-200)*(h-200))-1) > 0.01 {
+		t.Errorf("incorrect area: %v", a)
+	}
+}
+
+func TestBuffer_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Buffer(g, 10)
+	}
+}
+
+func TestBuffer_pole(t *testing.T) {
+	for _, center := range []geo.Point{{0, 89.999}, {45, -89.9995}} {
+		b := Buffer(center, 1000)
+		if len(b) != 1 {
+			t.Fatalf("expected one polygon: %v", b)
+		}
+
+		if bound := b.Bound(); bound.Max[1] != 90 && bound.Min[1] != -90 {
+			t.Errorf("should go around the pole: %v", bound)
+		}
+
+		for _, p := range b[0][0] {
+			if math.Abs(p[1]) == 90 || math.Abs(p[0]) == 180 {
+				// closed over the pole along the antimeridian
+				continue
+			}
+
+			if d := DistanceHaversine(center, p); math.Abs(d-1000) > 1e-3 {
+				t.Errorf("incorrect distance: %v", d)
+			}
+		}
+
+		if a := Area(b); math.Abs(a/(math.Pi*1000*1000)-1) > 0.01 {
+			t.Errorf("incorrect area: %v", a)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package geometries
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// Centroid returns the centroid of the geometry on the sphere, the center of mass
+// of its areas, or of its lines if it has no area, or of its points.
+// Areas are interpreted as in PolygonContains so polygons that go around a pole
+// or cross the antimeridian are supported. Returns the zero point for empty
+// geometries or if the centroid is not defined, e.g. for a band around the equator.
+func Centroid(g geo.Geometry) geo.Point {
+	m := &moments{}
+	m.add(g)
+
+	for _, v := range [3]vector{m.area, m.line, m.point} {
+		if l := v.length(); l > 1e-15 {
+			return v.scale(1 / l).point()
+		}
+	}
+
+	return geo.Point{}
+}
+
+// moments are the integrals of the position over the areas, lines and points.
+type moments struct {
+	area, line, point vector
+}
+
+func (m *moments) add(g geo.Geometry) {
+	switch g := g.(type) {
+	case nil:
+	case geo.Point:
+		m.point = m.point.add(toVector(g))
+	case geo.MultiPoint:
+		for _, p := range g {
+			m.point = m.point.add(toVector(p))
+		}
+	case geo.LineString:
+		m.addLine(g)
+	case geo.MultiLineString:
+		for _, ls := range g {
+			m.addLine(ls)
+		}
+	case geo.Ring:
+		m.addPolygon(geo.Polygon{g})
+	case geo.Polygon:
+		m.addPolygon(g)
+	case geo.MultiPolygon:
+		for _, p := range g {
+			m.addPolygon(p)
+		}
+	case geo.Collection:
+		for _, c := range g {
+			m.add(c)
+		}
+	case geo.Bound:
+		m.addPolygon(geo.Polygon{g.ToRing()})
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+// addLine adds the moments of the arcs, (a+b)·tan(θ/2) for an arc of angle θ,
+// and of the points so that lines without length are not lost.
+func (m *moments) addLine(ls geo.LineString) {
+	for i, p := range ls {
+		m.point = m.point.add(toVector(p))
+		if i == 0 {
+			continue
+		}
+
+		a, b := toVector(ls[i-1]), toVector(p)
+		m.line = m.line.add(a.add(b).scale(math.Tan(a.angle(b) / 2)))
+	}
+}
+
+func (m *moments) addPolygon(p geo.Polygon) {
+	for i, r := range p {
+		if len(r) < 3 {
+			continue
+		}
+
+		ls := make(geo.LineString, 0, len(r)+1)
+		ls = append(ls, r...)
+		if r[0] != r[len(r)-1] {
+			ls = append(ls, r[0])
+		}
+		m.addLine(ls)
+
+		v := ringMoment(r, i > 0)
+		if i > 0 {
+			v = v.scale(-1)
+		}
+
+		m.area = m.area.add(v)
+	}
+}
+
+// ringMoment returns the integral of the position over the inside of the ring.
+// By Stokes' theorem it is half the sum of the normals of the edges times
+// their angles, for the area to the left of the edges.
+func ringMoment(r geo.Ring, hole bool) vector {
+	var left vector
+	forEachEdge(r, func(a, b geo.Point) {
+		va, vb := toVector(a), toVector(b)
+		if n := va.cross(vb); n.length() > 0 {
+			left = left.add(n.scale(va.angle(vb) / n.length() / 2))
+		}
+	})
+
+	var inside bool
+	if winding := ringWinding(r); winding == 0 {
+		inside = unwrappedArea(r) > 0
+	} else {
+		inside = ringContainsNorthPole(r, winding, hole) == (winding > 0)
+	}
+
+	if !inside {
+		return left.scale(-1)
+	}
+
+	return left
+}
+
+// unwrappedArea returns the signed area of the ring in degrees
+// with the longitudes made continuous across the antimeridian.
+func unwrappedArea(r geo.Ring) (area float64) {
+	lon := r[0][0]
+	prev := geo.Point{lon, r[0][1]}
+	forEachEdge(r, func(a, b geo.Point) {
+		lon += lonDelta(a[0], b[0])
+		p := geo.Point{lon, b[1]}
+		area += prev[0]*p[1] - p[0]*prev[1]
+		prev = p
+	})
+
+	return area / 2
+}
@@ -0,0 +1,109 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestCentroid(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   geo.Geometry
+		result geo.Point
+	}{
+		{
+			name:   "point",
+			geom:   geo.Point{10, 20},
+			result: geo.Point{10, 20},
+		},
+		{
+			name:   "points across the antimeridian",
+			geom:   geo.MultiPoint{{170, 0}, {-170, 0}},
+			result: geo.Point{180, 0},
+		},
+		{
+			name:   "line",
+			geom:   geo.LineString{{-10, 0}, {0, 0}, {30, 0}},
+			result: geo.Point{10, 0},
+		},
+		{
+			name:   "square",
+			geom:   geo.Polygon{{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}}},
+			result: geo.Point{0, 0},
+		},
+		{
+			name:   "square reversed",
+			geom:   geo.Polygon{{{-1, -1}, {-1, 1}, {1, 1}, {1, -1}, {-1, -1}}},
+			result: geo.Point{0, 0},
+		},
+		{
+			name:   "crossing the antimeridian",
+			geom:   geo.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}},
+			result: geo.Point{180, 0},
+		},
+		{
+			name:   "north cap",
+			geom:   capRing(80, false),
+			result: geo.Point{0, 90},
+		},
+		{
+			name:   "south cap",
+			geom:   geo.Polygon{capRing(-80, true)},
+			result: geo.Point{0, -90},
+		},
+		{
+			name: "with a hole",
+			geom: geo.Polygon{
+				{{-2, -1}, {2, -1}, {2, 1}, {-2, 1}, {-2, -1}},
+				{{-2, -1}, {-2, 1}, {0, 1}, {0, -1}, {-2, -1}},
+			},
+			// the top and bottom edges of the hole bend less than the ones of the square
+			result: geo.Point{0.999391, 0},
+		},
+		{
+			name: "areas over lines",
+			geom: geo.Collection{
+				geo.LineString{{50, 50}, {60, 50}},
+				geo.Polygon{{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}}},
+			},
+			result: geo.Point{0, 0},
+		},
+		{
+			name:   "empty",
+			geom:   geo.MultiPolygon{},
+			result: geo.Point{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Centroid(tc.geom)
+			if math.Abs(c[1]-tc.result[1]) > 1e-6 ||
+				(math.Abs(c[1]) < 90-1e-6 && math.Abs(lonDelta(c[0], tc.result[0])) > 1e-6) {
+				t.Errorf("incorrect centroid: %v != %v", c, tc.result)
+			}
+		})
+	}
+}
+
+func TestCentroid_greatCircleEdges(t *testing.T) {
+	// the great circle edges bend towards the pole, so the centroid
+	// is north of the planar one
+	p := geo.Polygon{{{-60, 40}, {60, 40}, {60, 60}, {-60, 60}, {-60, 40}}}
+	c := Centroid(p)
+	if c[1] <= 50 {
+		t.Errorf("should be north of 50: %v", c)
+	}
+
+	if !PolygonContains(p, c) {
+		t.Errorf("should be inside: %v", c)
+	}
+}
+
+func TestCentroid_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Centroid(g)
+	}
+}
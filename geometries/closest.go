@@ -0,0 +1,63 @@
+package geometries
+
+import (
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/closest"
+)
+
+var greatCircleMetric = closest.Metric{
+	Segment:   greatCircleSegment,
+	Intersect: arcIntersection,
+	Contains:  PolygonContains,
+}
+
+// ClosestPoints returns the closest points of the two lon/lat geometries and the
+// distance between them in meters, using great circle segments and the haversine
+// distance. Areas include their interior, tested on the sphere as in PolygonContains,
+// so if one geometry intersects or is inside the other the points are a common point
+// and the distance is 0.
+// If either geometry is empty the distance is +Inf.
+func ClosestPoints(a, b geo.Geometry) (pa, pb geo.Point, dist float64) {
+	return closest.Points(a, b, greatCircleMetric)
+}
+
+// arcIntersection returns a point where the great circle arcs [a1, a2] and [b1, b2] intersect.
+func arcIntersection(a1, a2, b1, b2 geo.Point) (geo.Point, bool) {
+	for _, p := range [2]geo.Point{a1, a2} {
+		if p == b1 || p == b2 {
+			return p, true
+		}
+	}
+
+	va1, va2, vb1, vb2 := toVector(a1), toVector(a2), toVector(b1), toVector(b2)
+	na, nb := va1.cross(va2), vb1.cross(vb2)
+
+	onArc := func(v1, v2, n, c vector) bool {
+		return v1.cross(c).dot(n) >= -1e-15 && c.cross(v2).dot(n) >= -1e-15
+	}
+
+	l := na.cross(nb)
+	if l.length() < 1e-15 {
+		// the arcs are on the same great circle or one has zero length,
+		// they intersect if an end point of one is on the other
+		for _, c := range [4]struct {
+			p      geo.Point
+			v1, v2 vector
+		}{{b1, va1, va2}, {b2, va1, va2}, {a1, vb1, vb2}, {a2, vb1, vb2}} {
+			v := toVector(c.p)
+			if c.v1.angle(v)+v.angle(c.v2)-c.v1.angle(c.v2) < 1e-12 {
+				return c.p, true
+			}
+		}
+
+		return geo.Point{}, false
+	}
+
+	for _, c := range [2]vector{l, l.scale(-1)} {
+		if onArc(va1, va2, na, c) && onArc(vb1, vb2, nb, c) {
+			return c.point(), true
+		}
+	}
+
+	return geo.Point{}, false
+}
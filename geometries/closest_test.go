@@ -0,0 +1,129 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestClosestPoints(t *testing.T) {
+	cases := []struct {
+		name   string
+		a, b   geo.Geometry
+		pa, pb geo.Point
+		dist   float64
+	}{
+		{
+			name: "points",
+			a:    geo.Point{-1.8444, 53.1506},
+			b:    geo.Point{0.1406, 52.2047},
+			pa:   geo.Point{-1.8444, 53.1506},
+			pb:   geo.Point{0.1406, 52.2047},
+			dist: 170389.801924,
+		},
+		{
+			name: "point and equator",
+			a:    geo.Point{5, 1},
+			b:    geo.LineString{{0, 0}, {10, 0}},
+			pa:   geo.Point{5, 1},
+			pb:   geo.Point{5, 0},
+			dist: DistanceHaversine(geo.Point{5, 1}, geo.Point{5, 0}),
+		},
+		{
+			name: "crossing lines",
+			a:    geo.LineString{{-1, 0}, {1, 0}},
+			b:    geo.LineString{{0, -1}, {0, 1}},
+			pa:   geo.Point{0, 0},
+			pb:   geo.Point{0, 0},
+		},
+		{
+			name: "point inside polygon",
+			a:    geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+			b:    geo.Point{1, 2},
+			pa:   geo.Point{1, 2},
+			pb:   geo.Point{1, 2},
+		},
+		{
+			name: "point inside polygon around the pole",
+			a:    geo.Polygon{{{0, 80}, {90, 80}, {180, 80}, {-90, 80}, {0, 80}}},
+			b:    geo.Point{45, 89},
+			pa:   geo.Point{45, 89},
+			pb:   geo.Point{45, 89},
+		},
+		{
+			name: "point inside polygon across the antimeridian",
+			a:    geo.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}},
+			b:    geo.Point{179, 1},
+			pa:   geo.Point{179, 1},
+			pb:   geo.Point{179, 1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pa, pb, d := ClosestPoints(tc.a, tc.b)
+			if DistanceHaversine(pa, tc.pa) > 1e-6 || DistanceHaversine(pb, tc.pb) > 1e-6 || math.Abs(d-tc.dist) > epsilon {
+				t.Errorf("incorrect result: %v %v %v", pa, pb, d)
+			}
+		})
+	}
+}
+
+func TestClosestPoints_greatCircle(t *testing.T) {
+	// the great circle between the points bulges north of the parallel
+	ls := geo.LineString{{-60, 60}, {60, 60}}
+	_, pb, d := ClosestPoints(geo.Point{0, 70}, ls)
+	if math.Abs(pb[0]) > epsilon || pb[1] <= 60 {
+		t.Errorf("incorrect point: %v", pb)
+	}
+
+	if d >= DistanceHaversine(geo.Point{0, 70}, geo.Point{0, 60}) {
+		t.Errorf("incorrect distance: %v", d)
+	}
+}
+
+func TestArcIntersection(t *testing.T) {
+	cases := []struct {
+		name           string
+		a1, a2, b1, b2 geo.Point
+		intersects     bool
+	}{
+		{
+			name: "cross",
+			a1:   geo.Point{-10, 0}, a2: geo.Point{10, 0},
+			b1: geo.Point{0, -10}, b2: geo.Point{0, 10},
+			intersects: true,
+		},
+		{
+			name: "circles cross outside the arcs",
+			a1:   geo.Point{-10, 0}, a2: geo.Point{-5, 0},
+			b1: geo.Point{0, -10}, b2: geo.Point{0, 10},
+		},
+		{
+			name: "same great circle overlap",
+			a1:   geo.Point{0, 0}, a2: geo.Point{10, 0},
+			b1: geo.Point{5, 0}, b2: geo.Point{20, 0},
+			intersects: true,
+		},
+		{
+			name: "same great circle apart",
+			a1:   geo.Point{0, 0}, a2: geo.Point{10, 0},
+			b1: geo.Point{15, 0}, b2: geo.Point{20, 0},
+		},
+		{
+			name: "touch at end point",
+			a1:   geo.Point{0, 0}, a2: geo.Point{10, 10},
+			b1: geo.Point{10, 10}, b2: geo.Point{20, 0},
+			intersects: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, ok := arcIntersection(tc.a1, tc.a2, tc.b1, tc.b2); ok != tc.intersects {
+				t.Errorf("incorrect intersection: %v", ok)
+			}
+		})
+	}
+}
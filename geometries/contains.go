@@ -0,0 +1,149 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// RingContains returns true if the point is inside the ring on the sphere,
+// edges are great circle arcs. A ring that goes around a pole contains it,
+// the north pole if the ring goes east, i.e. counter-clockwise as in RFC 7946,
+// and the south pole if it goes west, or the pole that is one of its vertices.
+// Rings that do not go around a pole contain the side without the poles,
+// whatever their orientation. Points on the boundary are considered within.
+func RingContains(r geo.Ring, point geo.Point) bool {
+	return ringContains(r, point, false)
+}
+
+// PolygonContains checks if the point is within the polygon on the sphere.
+// Points on the boundary are considered in. Holes that go around a pole
+// contain the north pole if they go west, see RingContains.
+func PolygonContains(p geo.Polygon, point geo.Point) bool {
+	if len(p) == 0 || !ringContains(p[0], point, false) {
+		return false
+	}
+
+	for i := 1; i < len(p); i++ {
+		if ringContains(p[i], point, true) && !onRing(p[i], point) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MultiPolygonContains checks if the point is within the multi-polygon on the sphere.
+// Points on the boundary are considered in.
+func MultiPolygonContains(mp geo.MultiPolygon, point geo.Point) bool {
+	for _, p := range mp {
+		if PolygonContains(p, point) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ringContains(r geo.Ring, point geo.Point, hole bool) bool {
+	if len(r) < 3 {
+		return false
+	}
+
+	if onRing(r, point) {
+		return true
+	}
+
+	// the number of edges between the point and the north pole
+	odd := false
+	forEachEdge(r, func(a, b geo.Point) {
+		if lat, ok := meridianCrossing(a, b, point[0]); ok && lat > point[1] {
+			odd = !odd
+		}
+	})
+
+	winding := ringWinding(r)
+	if winding == 0 {
+		return odd
+	}
+
+	return odd != ringContainsNorthPole(r, winding, hole)
+}
+
+// ringContainsNorthPole returns which of the poles is inside a ring that goes around them.
+func ringContainsNorthPole(r geo.Ring, winding int, hole bool) bool {
+	for _, p := range r {
+		switch p[1] {
+		case 90:
+			return true
+		case -90:
+			return false
+		}
+	}
+
+	return (winding > 0) != hole
+}
+
+// ringWinding returns how many times the ring goes around the poles towards the east.
+func ringWinding(r geo.Ring) int {
+	var sum float64
+	forEachEdge(r, func(a, b geo.Point) {
+		sum += lonDelta(a[0], b[0])
+	})
+
+	return int(math.Round(sum / 360))
+}
+
+// meridianCrossing returns the latitude where the great circle arc crosses the meridian.
+// A vertex on the meridian is taken to be east of it,
+// so that the arcs through a vertex on the meridian are counted once.
+func meridianCrossing(a, b geo.Point, lon float64) (float64, bool) {
+	da, db := lonDelta(lon, a[0]), lonDelta(lon, b[0])
+	if (da >= 0) == (db >= 0) || math.Abs(db-da) >= 180 {
+		return 0, false
+	}
+
+	n := toVector(a).cross(toVector(b))
+	rlon := deg2rad(lon)
+	d := n.cross(vector{-math.Sin(rlon), math.Cos(rlon), 0})
+	if d.dot(vector{math.Cos(rlon), math.Sin(rlon), 0}) < 0 {
+		d = d.scale(-1)
+	}
+
+	if d.length() == 0 {
+		return 0, false
+	}
+
+	return rad2deg(math.Atan2(d[2], math.Hypot(d[0], d[1]))), true
+}
+
+// onRing returns true if the point is on one of the edges of the ring.
+func onRing(r geo.Ring, point geo.Point) (on bool) {
+	v := toVector(point)
+	forEachEdge(r, func(a, b geo.Point) {
+		if on {
+			return
+		}
+
+		if a == point || b == point {
+			on = true
+			return
+		}
+
+		va, vb := toVector(a), toVector(b)
+		on = math.Abs(va.angle(v)+v.angle(vb)-va.angle(vb)) < 1e-12
+	})
+
+	return on
+}
+
+// forEachEdge calls the function for the edges of the ring, closing it if needed
+// and skipping the edges without length.
+func forEachEdge(r geo.Ring, f func(a, b geo.Point)) {
+	for i := range r {
+		a, b := r[i], r[(i+1)%len(r)]
+		if a != b {
+			f(a, b)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package geometries
+
+import (
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+// capRing returns a ring along the latitude going east, or west if reversed.
+func capRing(lat float64, west bool) geo.Ring {
+	r := geo.Ring{}
+	for lon := -180.0; lon < 180; lon += 30 {
+		r = append(r, geo.Point{lon, lat})
+	}
+
+	if west {
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+	}
+
+	return append(r, r[0])
+}
+
+func TestRingContains(t *testing.T) {
+	square := geo.Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+	cases := []struct {
+		name   string
+		ring   geo.Ring
+		point  geo.Point
+		result bool
+	}{
+		{name: "square", ring: square, point: geo.Point{0.5, 0.5}, result: true},
+		{name: "square outside", ring: square, point: geo.Point{1.5, 0.5}, result: false},
+		{name: "square on edge", ring: square, point: geo.Point{1, 0.5}, result: true},
+		{name: "square vertex", ring: square, point: geo.Point{1, 1}, result: true},
+		{name: "square reversed", ring: geo.Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}, point: geo.Point{0.5, 0.5}, result: true},
+		{name: "square not closed", ring: square[:4], point: geo.Point{0.5, 0.5}, result: true},
+		{name: "vertex on the meridian", ring: geo.Ring{{0, 0}, {1, 1}, {0, 2}, {-1, 1}, {0, 0}}, point: geo.Point{0, 1.5}, result: true},
+		{name: "vertex above on the meridian", ring: geo.Ring{{0, 0}, {1, 1}, {0, 2}, {-1, 1}, {0, 0}}, point: geo.Point{0, -1}, result: false},
+		{
+			name:   "crossing the antimeridian",
+			ring:   geo.Ring{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}},
+			point:  geo.Point{180, 0},
+			result: true,
+		},
+		{
+			name:   "crossing the antimeridian outside",
+			ring:   geo.Ring{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}},
+			point:  geo.Point{0, 0},
+			result: false,
+		},
+		{
+			// the edges bend towards the pole, to 67.2 and 73.9 on the meridian
+			name:   "great circle edges",
+			ring:   geo.Ring{{-60, 50}, {60, 50}, {60, 60}, {-60, 60}, {-60, 50}},
+			point:  geo.Point{0, 70},
+			result: true,
+		},
+		{
+			name:   "great circle edges below",
+			ring:   geo.Ring{{-60, 50}, {60, 50}, {60, 60}, {-60, 60}, {-60, 50}},
+			point:  geo.Point{0, 55},
+			result: false,
+		},
+		{name: "north cap", ring: capRing(80, false), point: geo.Point{10, 85}, result: true},
+		{name: "north cap pole", ring: capRing(80, false), point: geo.Point{0, 90}, result: true},
+		{name: "north cap outside", ring: capRing(80, false), point: geo.Point{10, 0}, result: false},
+		{name: "south cap", ring: capRing(-80, true), point: geo.Point{10, -85}, result: true},
+		{name: "south cap outside", ring: capRing(-80, true), point: geo.Point{10, 85}, result: false},
+		{
+			name:   "through the south pole",
+			ring:   geo.Ring{{-180, -90}, {-180, -60}, {-90, -60}, {0, -60}, {90, -60}, {180, -60}, {180, -90}, {-180, -90}},
+			point:  geo.Point{45, -80},
+			result: true,
+		},
+		{
+			name:   "through the south pole outside",
+			ring:   geo.Ring{{-180, -90}, {-180, -60}, {-90, -60}, {0, -60}, {90, -60}, {180, -60}, {180, -90}, {-180, -90}},
+			point:  geo.Point{45, 0},
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := RingContains(tc.ring, tc.point); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// the arctic ocean south of 80
+	p := geo.Polygon{capRing(60, false), capRing(80, true)}
+	cases := []struct {
+		name   string
+		point  geo.Point
+		result bool
+	}{
+		{name: "inside", point: geo.Point{100, 70}, result: true},
+		{name: "in the hole", point: geo.Point{100, 85}, result: false},
+		{name: "on the hole", point: geo.Point{0, 80}, result: true},
+		{name: "south", point: geo.Point{100, 50}, result: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := PolygonContains(p, tc.point); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+
+	mp := geo.MultiPolygon{{capRing(-80, true)}, p}
+	if !MultiPolygonContains(mp, geo.Point{0, -85}) || MultiPolygonContains(mp, geo.Point{0, 0}) {
+		t.Errorf("incorrect multi polygon result")
+	}
+}
@@ -0,0 +1,93 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// CrossTrackDistance returns the distance in meters from the point to the great circle
+// through start and end, positive if the point is to the right of the path and negative
+// if it is to the left. Use ClosestPoints for the distance to the segment itself.
+func CrossTrackDistance(p, start, end geo.Point) float64 {
+	d := angularDistance(start, p)
+	b := deg2rad(Bearing(start, p) - Bearing(start, end))
+	return math.Asin(math.Sin(d)*math.Sin(b)) * geo.EarthRadius
+}
+
+// AlongTrackDistance returns the distance in meters from start along the great circle
+// through start and end to the point on it closest to the given point,
+// negative if that is behind start.
+func AlongTrackDistance(p, start, end geo.Point) float64 {
+	d := angularDistance(start, p)
+	b := deg2rad(Bearing(start, p) - Bearing(start, end))
+	xt := math.Asin(math.Sin(d) * math.Sin(b))
+	at := math.Acos(math.Min(1, math.Cos(d)/math.Cos(xt)))
+	if math.Cos(b) < 0 {
+		at = -at
+	}
+
+	return at * geo.EarthRadius
+}
+
+// GreatCircleIntersections returns the two antipodal points where the great circle
+// through a1 and a2 crosses the one through b1 and b2, the one closer to a1 first.
+// Returns false if the great circles are the same or a pair of points does not
+// define one, i.e. they are equal or antipodal.
+func GreatCircleIntersections(a1, a2, b1, b2 geo.Point) (geo.Point, geo.Point, bool) {
+	na := toVector(a1).cross(toVector(a2))
+	nb := toVector(b1).cross(toVector(b2))
+	return circleIntersections(na, nb, toVector(a1))
+}
+
+// BearingIntersection returns the point where the great circle paths leaving the points
+// at the bearings, in degrees from north, cross. Of the two antipodal crossings it is
+// the one the paths are heading towards, by the sum of the cosines of the angles to it.
+// Returns false if the paths are on the same great circle.
+func BearingIntersection(p1 geo.Point, bearing1 float64, p2 geo.Point, bearing2 float64) (geo.Point, bool) {
+	v1, v2 := toVector(p1), toVector(p2)
+	d1, d2 := direction(p1, bearing1), direction(p2, bearing2)
+	i, j, ok := circleIntersections(v1.cross(d1), v2.cross(d2), v1)
+	if !ok {
+		return geo.Point{}, false
+	}
+
+	if c := toVector(i); d1.dot(c)+d2.dot(c) < 0 {
+		return j, true
+	}
+
+	return i, true
+}
+
+// circleIntersections returns the intersections of the great circles
+// with the normals, the one closer to v first.
+func circleIntersections(n1, n2, v vector) (geo.Point, geo.Point, bool) {
+	if n1.length() < 1e-15 || n2.length() < 1e-15 {
+		return geo.Point{}, geo.Point{}, false
+	}
+
+	c := n1.scale(1 / n1.length()).cross(n2.scale(1 / n2.length()))
+	if c.length() < 1e-12 {
+		return geo.Point{}, geo.Point{}, false
+	}
+
+	if c.dot(v) < 0 {
+		c = c.scale(-1)
+	}
+
+	return c.point(), c.scale(-1).point(), true
+}
+
+// direction returns the unit vector tangent to the sphere at the point towards the bearing.
+func direction(p geo.Point, bearing float64) vector {
+	lon, lat := deg2rad(p[0]), deg2rad(p[1])
+	north := vector{-math.Sin(lat) * math.Cos(lon), -math.Sin(lat) * math.Sin(lon), math.Cos(lat)}
+	east := vector{-math.Sin(lon), math.Cos(lon), 0}
+	b := deg2rad(bearing)
+	return north.scale(math.Cos(b)).add(east.scale(math.Sin(b)))
+}
+
+// angularDistance returns the angle in radians between the points from the center of the earth.
+func angularDistance(p1, p2 geo.Point) float64 {
+	return toVector(p1).angle(toVector(p2))
+}
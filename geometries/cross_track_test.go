@@ -0,0 +1,142 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestCrossTrackDistance(t *testing.T) {
+	// from https://www.movable-type.co.uk/scripts/latlong.html on a 6371 km sphere
+	start := geo.Point{-1.7297, 53.3206}
+	end := geo.Point{0.1334, 53.1887}
+	p := geo.Point{-0.7972, 53.2611}
+
+	scale := geo.EarthRadius / 6371e3
+	if d := CrossTrackDistance(p, start, end); math.Abs(d-(-307.5*scale)) > 0.1 {
+		t.Errorf("incorrect cross track distance: %v", d)
+	}
+
+	if d := AlongTrackDistance(p, start, end); math.Abs(d-62331*scale) > 1 {
+		t.Errorf("incorrect along track distance: %v", d)
+	}
+
+	// to the right when going the other way
+	if d := CrossTrackDistance(p, end, start); math.Abs(d-307.5*scale) > 0.1 {
+		t.Errorf("incorrect reverse cross track distance: %v", d)
+	}
+}
+
+func TestCrossTrackDistance_equator(t *testing.T) {
+	start, end := geo.Point{0, 0}, geo.Point{10, 0}
+	cases := []struct {
+		name       string
+		point      geo.Point
+		crossTrack float64
+		alongTrack float64
+	}{
+		{
+			name:       "on the path",
+			point:      geo.Point{5, 0},
+			crossTrack: 0,
+			alongTrack: Distance(start, geo.Point{5, 0}),
+		},
+		{
+			name:       "north is left",
+			point:      geo.Point{5, 1},
+			crossTrack: -Distance(geo.Point{5, 0}, geo.Point{5, 1}),
+			alongTrack: Distance(start, geo.Point{5, 0}),
+		},
+		{
+			name:       "south is right",
+			point:      geo.Point{5, -1},
+			crossTrack: Distance(geo.Point{5, 0}, geo.Point{5, 1}),
+			alongTrack: Distance(start, geo.Point{5, 0}),
+		},
+		{
+			name:       "behind",
+			point:      geo.Point{-2, 1},
+			crossTrack: -Distance(geo.Point{-2, 0}, geo.Point{-2, 1}),
+			alongTrack: -Distance(start, geo.Point{-2, 0}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := CrossTrackDistance(tc.point, start, end); math.Abs(d-tc.crossTrack) > 1e-6 {
+				t.Errorf("incorrect cross track distance: %v != %v", d, tc.crossTrack)
+			}
+
+			if d := AlongTrackDistance(tc.point, start, end); math.Abs(d-tc.alongTrack) > 1e-6 {
+				t.Errorf("incorrect along track distance: %v != %v", d, tc.alongTrack)
+			}
+		})
+	}
+}
+
+func TestBearingIntersection(t *testing.T) {
+	// from https://www.movable-type.co.uk/scripts/latlong.html
+	p1 := geo.Point{0.2545, 51.8853}
+	p2 := geo.Point{2.5735, 49.0034}
+	expected := geo.Point{4.5084, 50.9078}
+
+	i, ok := BearingIntersection(p1, 108.547, p2, 32.435)
+	if !ok || math.Abs(i[0]-expected[0]) > 1e-4 || math.Abs(i[1]-expected[1]) > 1e-4 {
+		t.Errorf("incorrect intersection: %v %v", i, ok)
+	}
+
+	// heading away the paths meet on the other side of the earth
+	i, ok = BearingIntersection(p1, 108.547+180, p2, 32.435+180)
+	if !ok || math.Abs(i[0]-(expected[0]-180)) > 1e-4 || math.Abs(i[1]+expected[1]) > 1e-4 {
+		t.Errorf("incorrect antipodal intersection: %v %v", i, ok)
+	}
+
+	if _, ok := BearingIntersection(geo.Point{0, 0}, 90, geo.Point{10, 0}, 270); ok {
+		t.Errorf("should not intersect on the same great circle")
+	}
+}
+
+func TestGreatCircleIntersections(t *testing.T) {
+	i, j, ok := GreatCircleIntersections(
+		geo.Point{-10, 0}, geo.Point{10, 0},
+		geo.Point{30, -10}, geo.Point{30, 10},
+	)
+	if !ok {
+		t.Fatalf("should intersect")
+	}
+
+	if math.Abs(i[0]-30) > 1e-9 || math.Abs(i[1]) > 1e-9 {
+		t.Errorf("incorrect first intersection: %v", i)
+	}
+
+	if math.Abs(j[0]+150) > 1e-9 || math.Abs(j[1]) > 1e-9 {
+		t.Errorf("incorrect second intersection: %v", j)
+	}
+
+	// closer to the first point first
+	i, _, _ = GreatCircleIntersections(
+		geo.Point{170, 0}, geo.Point{-170, 0},
+		geo.Point{30, -10}, geo.Point{30, 10},
+	)
+	if math.Abs(i[0]+150) > 1e-9 {
+		t.Errorf("incorrect first intersection: %v", i)
+	}
+
+	cases := []struct {
+		name           string
+		a1, a2, b1, b2 geo.Point
+	}{
+		{name: "same great circle", a1: geo.Point{0, 0}, a2: geo.Point{10, 0}, b1: geo.Point{20, 0}, b2: geo.Point{30, 0}},
+		{name: "equal points", a1: geo.Point{0, 0}, a2: geo.Point{0, 0}, b1: geo.Point{20, 0}, b2: geo.Point{30, 10}},
+		{name: "antipodal points", a1: geo.Point{0, 0}, a2: geo.Point{180, 0}, b1: geo.Point{20, 0}, b2: geo.Point{30, 10}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, ok := GreatCircleIntersections(tc.a1, tc.a2, tc.b1, tc.b2); ok {
+				t.Errorf("should not intersect")
+			}
+		})
+	}
+}
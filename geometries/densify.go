@@ -0,0 +1,84 @@
+package geometries
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// Densify returns a copy of the geometry with points added along the great circles
+// between the vertices, so that no segment is longer than the max distance in meters.
+// The added longitudes are in [-180, 180], use the antimeridian package to split
+// the result where it crosses the antimeridian. Points and bounds are returned unchanged.
+// The max distance must be positive.
+func Densify(g geo.Geometry, maxDistance float64) geo.Geometry {
+	if !(maxDistance > 0) {
+		panic(fmt.Sprintf("max distance must be positive: %v", maxDistance))
+	}
+
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case geo.Point, geo.MultiPoint, geo.Bound:
+		return g
+	case geo.LineString:
+		return densifyLineString(g, maxDistance)
+	case geo.MultiLineString:
+		mls := make(geo.MultiLineString, 0, len(g))
+		for _, ls := range g {
+			mls = append(mls, densifyLineString(ls, maxDistance))
+		}
+
+		return mls
+	case geo.Ring:
+		return geo.Ring(densifyLineString(geo.LineString(g), maxDistance))
+	case geo.Polygon:
+		return densifyPolygon(g, maxDistance)
+	case geo.MultiPolygon:
+		mp := make(geo.MultiPolygon, 0, len(g))
+		for _, p := range g {
+			mp = append(mp, densifyPolygon(p, maxDistance))
+		}
+
+		return mp
+	case geo.Collection:
+		c := make(geo.Collection, 0, len(g))
+		for _, sub := range g {
+			c = append(c, Densify(sub, maxDistance))
+		}
+
+		return c
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func densifyLineString(ls geo.LineString, maxDistance float64) geo.LineString {
+	if len(ls) == 0 {
+		return geo.LineString{}
+	}
+
+	result := make(geo.LineString, 0, len(ls))
+	result = append(result, ls[0])
+	for i := 1; i < len(ls); i++ {
+		a, b := ls[i-1], ls[i]
+		n := int(math.Ceil(DistanceHaversine(a, b) / maxDistance))
+		for k := 1; k < n; k++ {
+			result = append(result, IntermediatePoint(a, b, float64(k)/float64(n)))
+		}
+
+		result = append(result, b)
+	}
+
+	return result
+}
+
+func densifyPolygon(p geo.Polygon, maxDistance float64) geo.Polygon {
+	result := make(geo.Polygon, 0, len(p))
+	for _, r := range p {
+		result = append(result, geo.Ring(densifyLineString(geo.LineString(r), maxDistance)))
+	}
+
+	return result
+}
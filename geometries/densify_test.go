@@ -0,0 +1,63 @@
+package geometries
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestDensify(t *testing.T) {
+	// about 1112 km along the equator
+	ls := geo.LineString{{0, 0}, {10, 0}}
+	d := Densify(ls, 300000).(geo.LineString)
+	if len(d) != 5 {
+		t.Fatalf("incorrect number of points: %v", d)
+	}
+
+	for i, p := range d {
+		if math.Abs(p[0]-2.5*float64(i)) > epsilon || math.Abs(p[1]) > epsilon {
+			t.Errorf("incorrect point: %v", p)
+		}
+	}
+
+	// the input is not modified
+	if !reflect.DeepEqual(ls, geo.LineString{{0, 0}, {10, 0}}) {
+		t.Errorf("input was modified: %v", ls)
+	}
+}
+
+func TestDensify_greatCircle(t *testing.T) {
+	// the great circle from New York to Tokyo goes up to about 70 degrees north
+	ls := geo.LineString{{-74, 40.7}, {139.7, 35.7}}
+	d := Densify(ls, 100000).(geo.LineString)
+
+	max := 0.0
+	for i := 1; i < len(d); i++ {
+		if s := DistanceHaversine(d[i-1], d[i]); s > 100000+1e-6 {
+			t.Errorf("segment too long: %v", s)
+		}
+
+		max = math.Max(max, d[i][1])
+	}
+
+	if max < 69 {
+		t.Errorf("should go north: %v", max)
+	}
+}
+
+func TestDensify_types(t *testing.T) {
+	poly := geo.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	if p := Densify(poly, 500000).(geo.Polygon); len(p[0]) != 13 {
+		t.Errorf("incorrect ring: %v", p)
+	}
+
+	if p := Densify(geo.Point{1, 2}, 1); p != (geo.Point{1, 2}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	for _, g := range geo.AllGeometries {
+		Densify(g, 1000)
+	}
+}
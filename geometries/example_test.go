@@ -50,3 +50,43 @@ func ExampleLength() {
 	// Output:
 	// 325 meters
 }
+
+func ExampleLocatePoint() {
+	road := geo.LineString{{-122.4163816, 37.7792782}, {-122.4151027, 37.7789118}, {-122.4152143, 37.7794274}}
+
+	fraction, distance := geometries.LocatePoint(road, geo.Point{-122.4157, 37.7789})
+	fmt.Printf("%0.2f %0.1f meters\n", fraction, distance)
+
+	p := geometries.InterpolatePoint(road, fraction)
+	fmt.Printf("%0.7f\n", p)
+	// Output:
+	// 0.40 70.7 meters
+	// [-122.4156258 37.7790617]
+}
+
+func ExampleRhumbBearing() {
+	// sailing east across the antimeridian
+	fiji := geo.Point{178.44, -18.14}
+	samoa := geo.Point{-171.76, -13.83}
+
+	fmt.Printf("%0.1f degrees\n", geometries.RhumbBearing(fiji, samoa))
+	fmt.Printf("%0.0f km\n", geometries.RhumbDistance(fiji, samoa)/1000)
+	fmt.Printf("%0.2f\n", geometries.RhumbMidpoint(fiji, samoa))
+	// Output:
+	// 65.4 degrees
+	// 1153 km
+	// [-176.63 -15.99]
+}
+
+func ExampleCrossTrackDistance() {
+	// a flight from London Heathrow to New York JFK
+	lhr := geo.Point{-0.4614, 51.4700}
+	jfk := geo.Point{-73.7781, 40.6413}
+	aircraft := geo.Point{-30, 54}
+
+	fmt.Printf("%0.0f km off track\n", geometries.CrossTrackDistance(aircraft, lhr, jfk)/1000)
+	fmt.Printf("%0.0f km along\n", geometries.AlongTrackDistance(aircraft, lhr, jfk)/1000)
+	// Output:
+	// 61 km off track
+	// 1995 km along
+}
@@ -0,0 +1,84 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/linear"
+)
+
+// greatCircleSegment defines segments as the shorter great circle arcs.
+var greatCircleSegment = linear.Segment{
+	Distance:    DistanceHaversine,
+	Closest:     closestOnArc,
+	Interpolate: IntermediatePoint,
+}
+
+// LocatePoint returns the fraction of the length and the distance in meters along
+// the line string of the point on the line closest to p, using great circle segments
+// and the haversine distance. It panics if the line string is empty.
+func LocatePoint(ls geo.LineString, p geo.Point) (fraction, distance float64) {
+	return linear.Locate(ls, p, greatCircleSegment)
+}
+
+// InterpolatePoint returns the point at the fraction, between 0 and 1, of the length
+// of the line string along great circles. It panics if the line string is empty.
+func InterpolatePoint(ls geo.LineString, fraction float64) geo.Point {
+	return linear.Interpolate(ls, fraction, greatCircleSegment)
+}
+
+// Substring returns the part of the line string between the fractions, from 0 to 1,
+// of its length along great circles. If from is after to the result is reversed.
+// It panics if the line string is empty.
+func Substring(ls geo.LineString, from, to float64) geo.LineString {
+	return linear.Substring(ls, from, to, greatCircleSegment)
+}
+
+// IntermediatePoint returns the point at the fraction
+// of the great circle path between the two points.
+// The path between antipodal points is undefined, every great circle
+// through them is as short, so for them it follows the meridian of p
+// heading north, or south from the north pole.
+func IntermediatePoint(p, p2 geo.Point, fraction float64) geo.Point {
+	a, b := toVector(p), toVector(p2)
+	angle := a.angle(b)
+	if angle == 0 {
+		return p
+	}
+
+	if math.Pi-angle < 1e-9 {
+		lon, lat := deg2rad(p[0]), deg2rad(p[1])
+		north := vector{-math.Sin(lat) * math.Cos(lon), -math.Sin(lat) * math.Sin(lon), math.Cos(lat)}
+		if p[1] >= 90 {
+			north = vector{math.Cos(lon), math.Sin(lon), 0}
+		}
+
+		return a.scale(math.Cos(fraction * angle)).add(north.scale(math.Sin(fraction * angle))).point()
+	}
+
+	s := math.Sin(angle)
+	v := a.scale(math.Sin((1-fraction)*angle) / s).add(b.scale(math.Sin(fraction*angle) / s))
+	return v.point()
+}
+
+// closestOnArc returns the fraction along the great circle arc [a, b] of its point closest to p.
+func closestOnArc(a, b, p geo.Point) float64 {
+	va, vb, vp := toVector(a), toVector(b), toVector(p)
+	n := va.cross(vb)
+	if n.length() == 0 {
+		return 0
+	}
+
+	// project p on the plane of the great circle
+	n = n.scale(1 / n.length())
+	q := vp.add(n.scale(-vp.dot(n)))
+	if q.length() > 0 && va.cross(q).dot(n) >= 0 && q.cross(vb).dot(n) >= 0 {
+		return va.angle(q) / va.angle(vb)
+	}
+
+	if vp.angle(va) <= vp.angle(vb) {
+		return 0
+	}
+
+	return 1
+}
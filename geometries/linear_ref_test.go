@@ -0,0 +1,162 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestLocatePoint(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {10, 0}, {10, 10}}
+	cases := []struct {
+		name     string
+		point    geo.Point
+		fraction float64
+		distance float64
+	}{
+		{
+			name:     "beside the equator",
+			point:    geo.Point{5, 1},
+			fraction: 0.25,
+			distance: DistanceHaversine(geo.Point{0, 0}, geo.Point{5, 0}),
+		},
+		{
+			name:     "on the meridian",
+			point:    geo.Point{10, 5},
+			fraction: 0.75,
+			distance: 1.5 * DistanceHaversine(geo.Point{0, 0}, geo.Point{10, 0}),
+		},
+		{
+			name:     "before the start",
+			point:    geo.Point{-3, 1},
+			fraction: 0,
+			distance: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, d := LocatePoint(ls, tc.point)
+			if math.Abs(f-tc.fraction) > 1e-9 || math.Abs(d-tc.distance) > 1e-3 {
+				t.Errorf("incorrect location: %v %v", f, d)
+			}
+		})
+	}
+}
+
+func TestInterpolatePoint(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {10, 0}, {10, 10}}
+	cases := []struct {
+		fraction float64
+		point    geo.Point
+	}{
+		{fraction: 0, point: geo.Point{0, 0}},
+		{fraction: 0.25, point: geo.Point{5, 0}},
+		{fraction: 0.5, point: geo.Point{10, 0}},
+		{fraction: 0.75, point: geo.Point{10, 5}},
+		{fraction: 1.5, point: geo.Point{10, 10}},
+	}
+
+	for _, tc := range cases {
+		p := InterpolatePoint(ls, tc.fraction)
+		if math.Abs(p[0]-tc.point[0]) > epsilon || math.Abs(p[1]-tc.point[1]) > epsilon {
+			t.Errorf("incorrect point for %v: %v != %v", tc.fraction, p, tc.point)
+		}
+	}
+}
+
+func TestInterpolatePoint_locate(t *testing.T) {
+	ls := geo.LineString{{-122.4, 37.7}, {-73.9, 40.7}, {-0.1, 51.5}}
+	for _, f := range []float64{0, 0.1, 0.3, 0.5, 0.9, 1} {
+		p := InterpolatePoint(ls, f)
+		if l, _ := LocatePoint(ls, p); math.Abs(l-f) > 1e-9 {
+			t.Errorf("incorrect fraction: %v != %v", l, f)
+		}
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {10, 0}, {10, 10}}
+	s := Substring(ls, 0.25, 0.75)
+	expected := geo.LineString{{5, 0}, {10, 0}, {10, 5}}
+	if len(s) != len(expected) {
+		t.Fatalf("incorrect substring: %v", s)
+	}
+
+	for i := range s {
+		if math.Abs(s[i][0]-expected[i][0]) > epsilon || math.Abs(s[i][1]-expected[i][1]) > epsilon {
+			t.Errorf("incorrect substring: %v", s)
+		}
+	}
+
+	if l := LengthHaversine(s); math.Abs(l-LengthHaversine(ls)/2) > 1e-6 {
+		t.Errorf("incorrect length: %v", l)
+	}
+}
+
+func TestIntermediatePoint(t *testing.T) {
+	a, b := geo.Point{-1.8444, 53.1506}, geo.Point{0.1406, 52.2047}
+	m := Midpoint(a, b)
+	if p := IntermediatePoint(a, b, 0.5); math.Abs(p[0]-m[0]) > epsilon || math.Abs(p[1]-m[1]) > epsilon {
+		t.Errorf("incorrect point: %v != %v", p, m)
+	}
+
+	if p := IntermediatePoint(a, a, 0.5); p != a {
+		t.Errorf("incorrect point: %v", p)
+	}
+}
+
+func TestIntermediatePoint_antipodal(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     geo.Point
+		fraction float64
+		expected geo.Point
+	}{
+		{
+			name:     "equator",
+			a:        geo.Point{0, 0},
+			b:        geo.Point{180, 0},
+			fraction: 0.5,
+			expected: geo.Point{0, 90},
+		},
+		{
+			name:     "quarter",
+			a:        geo.Point{0, 0},
+			b:        geo.Point{180, 0},
+			fraction: 0.25,
+			expected: geo.Point{0, 45},
+		},
+		{
+			name:     "southern hemisphere",
+			a:        geo.Point{-30, -40},
+			b:        geo.Point{150, 40},
+			fraction: 0.5,
+			expected: geo.Point{-30, 50},
+		},
+		{
+			name:     "north pole",
+			a:        geo.Point{30, 90},
+			b:        geo.Point{30, -90},
+			fraction: 0.5,
+			expected: geo.Point{30, 0},
+		},
+		{
+			name:     "end",
+			a:        geo.Point{10, 20},
+			b:        geo.Point{-170, -20},
+			fraction: 1,
+			expected: geo.Point{-170, -20},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := IntermediatePoint(tc.a, tc.b, tc.fraction)
+			if d := DistanceHaversine(p, tc.expected); d > 1e-6 {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// RhumbDistance returns the distance in meters along the rhumb line, the path of
+// constant bearing, between the points. It is longer than the great circle Distance
+// unless the points are on the same meridian or on the equator.
+func RhumbDistance(p1, p2 geo.Point) float64 {
+	lat1, lat2 := deg2rad(p1[1]), deg2rad(p2[1])
+	dLat := lat2 - lat1
+	dLon := deg2rad(lonDelta(p1[0], p2[0]))
+	return math.Hypot(dLat, rhumbStretch(lat1, lat2)*dLon) * geo.EarthRadius
+}
+
+// RhumbBearing returns the constant bearing in degrees, clockwise from north
+// in [-180, 180], to travel from one point to the other along a rhumb line.
+func RhumbBearing(from, to geo.Point) float64 {
+	dLon := deg2rad(lonDelta(from[0], to[0]))
+	return rad2deg(math.Atan2(dLon, mercatorDelta(deg2rad(from[1]), deg2rad(to[1]))))
+}
+
+// RhumbPointAtBearingAndDistance returns the point at the distance in meters
+// from the point along the rhumb line with the bearing in degrees.
+// The longitude of the result is in [-180, 180).
+func RhumbPointAtBearingAndDistance(p geo.Point, bearing, distance float64) geo.Point {
+	d := distance / geo.EarthRadius
+	b := deg2rad(bearing)
+
+	lat1 := deg2rad(p[1])
+	lat2 := lat1 + d*math.Cos(b)
+
+	// past the pole
+	if lat2 > math.Pi/2 {
+		lat2 = math.Pi - lat2
+	} else if lat2 < -math.Pi/2 {
+		lat2 = -math.Pi - lat2
+	}
+
+	dLon := d * math.Sin(b) / rhumbStretch(lat1, lat2)
+	return geo.Point{geo.WrapLon(p[0] + rad2deg(dLon)), rad2deg(lat2)}
+}
+
+// RhumbMidpoint returns the half-way point along the rhumb line between the points.
+// The longitude of the result is in [-180, 180).
+func RhumbMidpoint(p, p2 geo.Point) geo.Point {
+	lat1, lat2 := deg2rad(p[1]), deg2rad(p2[1])
+	lon1 := deg2rad(p[0])
+	lon2 := lon1 + deg2rad(lonDelta(p[0], p2[0]))
+
+	lat := (lat1 + lat2) / 2
+	f1 := math.Log(math.Tan(math.Pi/4 + lat1/2))
+	f2 := math.Log(math.Tan(math.Pi/4 + lat2/2))
+	fm := math.Log(math.Tan(math.Pi/4 + lat/2))
+
+	lon := (lon1 + lon2) / 2
+	if f2 != f1 {
+		lon = ((lon2-lon1)*fm + lon1*f2 - lon2*f1) / (f2 - f1)
+	}
+
+	return geo.Point{geo.WrapLon(rad2deg(lon)), rad2deg(lat)}
+}
+
+// lonDelta returns the change in longitude in degrees from lon1 to lon2 the short way
+// around, so it crosses the antimeridian if that is shorter, in [-180, 180).
+func lonDelta(lon1, lon2 float64) float64 {
+	return geo.WrapLon(lon2 - lon1)
+}
+
+// mercatorDelta returns the difference in the Mercator projected latitude.
+func mercatorDelta(lat1, lat2 float64) float64 {
+	return math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2))
+}
+
+// rhumbStretch returns the ratio of the change in latitude to the change in the
+// Mercator projected latitude, the cosine of the latitude on an east-west line.
+func rhumbStretch(lat1, lat2 float64) float64 {
+	if d := mercatorDelta(lat1, lat2); math.Abs(d) > 1e-12 {
+		return (lat2 - lat1) / d
+	}
+
+	return math.Cos(lat1)
+}
@@ -0,0 +1,139 @@
+package geometries
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestRhumbDistance(t *testing.T) {
+	// Dover to Calais, 40.31 km on a sphere of radius 6371 km
+	p1, p2 := geo.Point{1.338, 51.127}, geo.Point{1.853, 50.964}
+	expected := 40307.7 * geo.EarthRadius / 6371000
+	if d := RhumbDistance(p1, p2); math.Abs(d-expected) > 1 {
+		t.Errorf("incorrect distance: %v != %v", d, expected)
+	}
+
+	// the great circle is shorter
+	if d := DistanceHaversine(p1, p2); d > RhumbDistance(p1, p2) {
+		t.Errorf("great circle should be shorter: %v", d)
+	}
+
+	// along the equator and a meridian they are the same
+	for _, q := range []geo.Point{{10, 0}, {0, 10}} {
+		if d, h := RhumbDistance(geo.Point{0, 0}, q), DistanceHaversine(geo.Point{0, 0}, q); math.Abs(d-h) > epsilon {
+			t.Errorf("incorrect distance: %v != %v", d, h)
+		}
+	}
+}
+
+func TestRhumbBearing(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to geo.Point
+		bearing  float64
+	}{
+		{
+			name:    "dover to calais",
+			from:    geo.Point{1.338, 51.127},
+			to:      geo.Point{1.853, 50.964},
+			bearing: 116.7219,
+		},
+		{
+			name:    "east across the antimeridian",
+			from:    geo.Point{179, 10},
+			to:      geo.Point{-179, 10},
+			bearing: 90,
+		},
+		{
+			name:    "west across the antimeridian",
+			from:    geo.Point{-179, 10},
+			to:      geo.Point{179, 10},
+			bearing: -90,
+		},
+		{
+			name:    "south",
+			from:    geo.Point{5, 10},
+			to:      geo.Point{5, -10},
+			bearing: 180,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if b := RhumbBearing(tc.from, tc.to); math.Abs(b-tc.bearing) > 1e-4 {
+				t.Errorf("incorrect bearing: %v != %v", b, tc.bearing)
+			}
+		})
+	}
+}
+
+func TestRhumbPointAtBearingAndDistance(t *testing.T) {
+	cases := []struct {
+		name   string
+		p1, p2 geo.Point
+	}{
+		{name: "dover to calais", p1: geo.Point{1.338, 51.127}, p2: geo.Point{1.853, 50.964}},
+		{name: "across the antimeridian", p1: geo.Point{170, -40}, p2: geo.Point{-170, -30}},
+		{name: "along a parallel", p1: geo.Point{-20, 60}, p2: geo.Point{40, 60}},
+		{name: "along a meridian", p1: geo.Point{-20, 60}, p2: geo.Point{-20, -60}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := RhumbPointAtBearingAndDistance(tc.p1, RhumbBearing(tc.p1, tc.p2), RhumbDistance(tc.p1, tc.p2))
+			if math.Abs(p[0]-tc.p2[0]) > epsilon || math.Abs(p[1]-tc.p2[1]) > epsilon {
+				t.Errorf("incorrect point: %v != %v", p, tc.p2)
+			}
+		})
+	}
+}
+
+func TestRhumbPointAtBearingAndDistance_pole(t *testing.T) {
+	// going north past the pole comes back down
+	p := RhumbPointAtBearingAndDistance(geo.Point{0, 80}, 0, 20*math.Pi/180*geo.EarthRadius)
+	if math.Abs(p[1]-80) > epsilon {
+		t.Errorf("incorrect point: %v", p)
+	}
+}
+
+func TestRhumbMidpoint(t *testing.T) {
+	cases := []struct {
+		name   string
+		p1, p2 geo.Point
+		result geo.Point
+	}{
+		{
+			name:   "dover to calais",
+			p1:     geo.Point{1.338, 51.127},
+			p2:     geo.Point{1.853, 50.964},
+			result: geo.Point{1.5957, 51.0455},
+		},
+		{
+			name:   "across the antimeridian",
+			p1:     geo.Point{179, 10},
+			p2:     geo.Point{-177, 10},
+			result: geo.Point{-179, 10},
+		},
+		{
+			name:   "along a meridian",
+			p1:     geo.Point{5, -10},
+			p2:     geo.Point{5, 30},
+			result: geo.Point{5, 10},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := RhumbMidpoint(tc.p1, tc.p2)
+			if math.Abs(m[0]-tc.result[0]) > 1e-4 || math.Abs(m[1]-tc.result[1]) > 1e-4 {
+				t.Errorf("incorrect midpoint: %v != %v", m, tc.result)
+			}
+
+			if d1, d2 := RhumbDistance(tc.p1, m), RhumbDistance(m, tc.p2); math.Abs(d1-d2) > 1e-3 {
+				t.Errorf("not half way: %v != %v", d1, d2)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package geometries
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// vector is a point on the unit sphere in earth centered coordinates,
+// x through lon 0, z through the north pole.
+type vector [3]float64
+
+func toVector(p geo.Point) vector {
+	lon, lat := deg2rad(p[0]), deg2rad(p[1])
+	return vector{
+		math.Cos(lat) * math.Cos(lon),
+		math.Cos(lat) * math.Sin(lon),
+		math.Sin(lat),
+	}
+}
+
+func (v vector) point() geo.Point {
+	return geo.Point{
+		rad2deg(math.Atan2(v[1], v[0])),
+		rad2deg(math.Atan2(v[2], math.Hypot(v[0], v[1]))),
+	}
+}
+
+func (v vector) dot(w vector) float64 {
+	return v[0]*w[0] + v[1]*w[1] + v[2]*w[2]
+}
+
+func (v vector) cross(w vector) vector {
+	return vector{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}
+}
+
+func (v vector) scale(f float64) vector {
+	return vector{v[0] * f, v[1] * f, v[2] * f}
+}
+
+func (v vector) add(w vector) vector {
+	return vector{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+func (v vector) length() float64 {
+	return math.Sqrt(v.dot(v))
+}
+
+// angle returns the angle between the vectors in radians.
+func (v vector) angle(w vector) float64 {
+	return math.Atan2(v.cross(w).length(), v.dot(w))
+}
@@ -0,0 +1,178 @@
+// Package closest finds the closest points of two geometries
+// for the geometry of a segment, e.g. planar or on the sphere.
+package closest
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/linear"
+)
+
+// Metric defines the geometry of the segments and areas.
+type Metric struct {
+	linear.Segment
+
+	// Intersect returns a point where the segments [a1, a2] and [b1, b2] intersect.
+	Intersect func(a1, a2, b1, b2 geo.Point) (geo.Point, bool)
+
+	// Contains returns true if the point is in the interior or on the boundary of the polygon.
+	Contains func(p geo.Polygon, point geo.Point) bool
+}
+
+// Points returns the closest points of the geometries and the distance between them.
+// If the geometries intersect, including a geometry inside the area of the other,
+// the points are a common point and the distance is 0.
+// If either geometry is empty the distance is +Inf.
+func Points(a, b geo.Geometry, m Metric) (pa, pb geo.Point, dist float64) {
+	ga, gb := &parts{}, &parts{}
+	ga.add(a)
+	gb.add(b)
+
+	if len(ga.points) == 0 || len(gb.points) == 0 {
+		return geo.Point{}, geo.Point{}, math.Inf(1)
+	}
+
+	if p, ok := ga.intersection(gb, m); ok {
+		return p, p, 0
+	}
+
+	dist = math.Inf(1)
+	try := func(p, q geo.Point) {
+		if d := m.Distance(p, q); d < dist {
+			pa, pb, dist = p, q, d
+		}
+	}
+
+	for _, p := range ga.points {
+		for _, q := range gb.points {
+			try(p, q)
+		}
+
+		for _, s := range gb.segments {
+			try(p, m.closest(s, p))
+		}
+	}
+
+	for _, q := range gb.points {
+		for _, s := range ga.segments {
+			try(m.closest(s, q), q)
+		}
+	}
+
+	return pa, pb, dist
+}
+
+// closest returns the point of the segment closest to p.
+func (m Metric) closest(s [2]geo.Point, p geo.Point) geo.Point {
+	if s[0] == s[1] {
+		return s[0]
+	}
+
+	f := m.Closest(s[0], s[1], p)
+	switch {
+	case f <= 0:
+		return s[0]
+	case f >= 1:
+		return s[1]
+	}
+
+	return m.Interpolate(s[0], s[1], f)
+}
+
+// parts are the vertices, segments and areas of a geometry.
+type parts struct {
+	points   []geo.Point
+	segments [][2]geo.Point
+	polygons []geo.Polygon
+}
+
+func (g *parts) add(geom geo.Geometry) {
+	switch geom := geom.(type) {
+	case nil:
+	case geo.Point:
+		g.points = append(g.points, geom)
+	case geo.MultiPoint:
+		g.points = append(g.points, geom...)
+	case geo.LineString:
+		g.line(geom)
+	case geo.MultiLineString:
+		for _, ls := range geom {
+			g.line(ls)
+		}
+	case geo.Ring:
+		g.polygon(geo.Polygon{geom})
+	case geo.Polygon:
+		g.polygon(geom)
+	case geo.MultiPolygon:
+		for _, p := range geom {
+			g.polygon(p)
+		}
+	case geo.Collection:
+		for _, c := range geom {
+			g.add(c)
+		}
+	case geo.Bound:
+		if !geom.IsEmpty() {
+			g.polygon(geom.ToPolygon())
+		}
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", geom))
+	}
+}
+
+func (g *parts) line(ls geo.LineString) {
+	g.points = append(g.points, ls...)
+	for i := 1; i < len(ls); i++ {
+		g.segments = append(g.segments, [2]geo.Point{ls[i-1], ls[i]})
+	}
+}
+
+func (g *parts) polygon(p geo.Polygon) {
+	for _, r := range p {
+		g.line(geo.LineString(r))
+	}
+
+	if len(p) > 0 && len(p[0]) > 0 {
+		g.polygons = append(g.polygons, p)
+	}
+}
+
+// intersection returns a point the geometries have in common.
+func (g *parts) intersection(o *parts, m Metric) (geo.Point, bool) {
+	for _, s1 := range g.segments {
+		for _, s2 := range o.segments {
+			if p, ok := m.Intersect(s1[0], s1[1], s2[0], s2[1]); ok {
+				return p, true
+			}
+		}
+	}
+
+	// a geometry inside the area of the other has all its points in the area
+	for _, poly := range o.polygons {
+		for _, p := range g.points {
+			if m.Contains(poly, p) {
+				return p, true
+			}
+		}
+	}
+
+	for _, poly := range g.polygons {
+		for _, p := range o.points {
+			if m.Contains(poly, p) {
+				return p, true
+			}
+		}
+	}
+
+	for _, p := range g.points {
+		for _, q := range o.points {
+			if p == q {
+				return p, true
+			}
+		}
+	}
+
+	return geo.Point{}, false
+}
@@ -0,0 +1,166 @@
+// Package linear implements linear referencing along line strings
+// for the geometry of a segment, e.g. planar or on the sphere.
+package linear
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// Segment defines the geometry of the segments of the line strings.
+type Segment struct {
+	Distance geo.DistanceFunc
+
+	// Closest returns the fraction along the segment [a, b] of its point closest to p.
+	Closest func(a, b, p geo.Point) float64
+
+	// Interpolate returns the point at the fraction along the segment [a, b].
+	Interpolate func(a, b geo.Point, fraction float64) geo.Point
+}
+
+// Locate returns the fraction of the length and the distance along
+// the line string of the point on the line closest to p.
+func Locate(ls geo.LineString, p geo.Point, s Segment) (fraction, distance float64) {
+	if len(ls) == 0 {
+		panic("empty LineString")
+	}
+
+	var total float64
+	closest := math.Inf(1)
+	for i := 1; i < len(ls); i++ {
+		a, b := ls[i-1], ls[i]
+		seg := s.Distance(a, b)
+
+		f := 0.0
+		if seg > 0 {
+			f = clamp(s.Closest(a, b, p))
+		}
+
+		if d := s.Distance(p, s.at(a, b, f)); d < closest {
+			closest = d
+			distance = total + f*seg
+		}
+
+		total += seg
+	}
+
+	if total == 0 {
+		return 0, 0
+	}
+
+	return distance / total, distance
+}
+
+// Interpolate returns the point at the fraction of the length of the line string.
+// The fraction is clamped to [0, 1].
+func Interpolate(ls geo.LineString, fraction float64, s Segment) geo.Point {
+	if len(ls) == 0 {
+		panic("empty LineString")
+	}
+
+	distance := clamp(fraction) * lineLength(ls, s)
+
+	var travelled float64
+	for i := 1; i < len(ls); i++ {
+		a, b := ls[i-1], ls[i]
+		seg := s.Distance(a, b)
+		if travelled+seg >= distance && seg > 0 {
+			return s.at(a, b, (distance-travelled)/seg)
+		}
+
+		travelled += seg
+	}
+
+	return ls[len(ls)-1]
+}
+
+// Substring returns the part of the line string between the fractions of its length.
+// The fractions are clamped to [0, 1], if from is after to the result is reversed.
+// The result has at least two points, they are equal if the fractions are.
+func Substring(ls geo.LineString, from, to float64, s Segment) geo.LineString {
+	if len(ls) == 0 {
+		panic("empty LineString")
+	}
+
+	if from > to {
+		result := Substring(ls, to, from, s)
+		result.Reverse()
+		return result
+	}
+
+	total := lineLength(ls, s)
+	start, end := clamp(from)*total, clamp(to)*total
+
+	var (
+		result    geo.LineString
+		travelled float64
+	)
+
+	add := func(p geo.Point) {
+		if len(result) == 0 || result[len(result)-1] != p {
+			result = append(result, p)
+		}
+	}
+
+	for i := 1; i < len(ls); i++ {
+		a, b := ls[i-1], ls[i]
+		seg := s.Distance(a, b)
+		if len(result) == 0 && travelled+seg >= start {
+			add(s.at(a, b, fractionOf(start-travelled, seg)))
+		}
+
+		if len(result) > 0 {
+			if travelled+seg >= end {
+				add(s.at(a, b, fractionOf(end-travelled, seg)))
+				break
+			}
+
+			add(b)
+		}
+
+		travelled += seg
+	}
+
+	switch len(result) {
+	case 0:
+		return geo.LineString{ls[len(ls)-1], ls[len(ls)-1]}
+	case 1:
+		return append(result, result[0])
+	}
+
+	return result
+}
+
+// at returns the point at the fraction along the segment,
+// the end points are returned exactly.
+func (s Segment) at(a, b geo.Point, f float64) geo.Point {
+	switch {
+	case f <= 0:
+		return a
+	case f >= 1:
+		return b
+	}
+
+	return s.Interpolate(a, b, f)
+}
+
+func lineLength(ls geo.LineString, s Segment) (sum float64) {
+	for i := 1; i < len(ls); i++ {
+		sum += s.Distance(ls[i-1], ls[i])
+	}
+
+	return
+}
+
+func fractionOf(d, length float64) float64 {
+	if length == 0 {
+		return 0
+	}
+
+	return d / length
+}
+
+func clamp(f float64) float64 {
+	return math.Max(0, math.Min(1, f))
+}
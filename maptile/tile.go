@@ -215,6 +215,35 @@ func At(ll geo.Point, z Zoom) Tile {
 	return t
 }
 
+// BoundRange returns the north west and the south east tiles of the range
+// covering the bound at the given zoom. If the bound crosses the antimeridian
+// min.X is larger than max.X and the range wraps around from the last column to 0.
+func BoundRange(b geo.BoundWrapped, z Zoom) (min, max Tile) {
+	min = At(geo.Point{b.Min[0], b.Max[1]}, z)
+	max = At(geo.Point{b.Max[0], b.Min[1]}, z)
+
+	// 180 is the east edge of the last column
+	last := uint32(1<<z) - 1
+	if min.X > last {
+		min.X = 0
+	}
+
+	if max.X > last {
+		max.X = last
+	}
+
+	if b.Crosses() && b.Min[0] < 180 && min.X <= max.X {
+		// the ends are in the same column, the range goes all the way around
+		if min.X == 0 {
+			max.X = last
+		} else {
+			max.X = min.X - 1
+		}
+	}
+
+	return min, max
+}
+
 // Fraction returns the precise tile fraction at the given zoom.
 // Will return 2^zoom-1 if the point is below 85.0511 S.
 func Fraction(ll geo.Point, z Zoom) (p geo.Point) {
@@ -265,6 +265,54 @@ func TestRange(t *testing.T) {
 	}
 }
 
+func TestBoundRange(t *testing.T) {
+	cases := []struct {
+		name     string
+		bound    geo.BoundWrapped
+		min, max Tile
+	}{
+		{
+			name:  "not crossing",
+			bound: geo.BoundWrapped{Min: geo.Point{-100, 10}, Max: geo.Point{-80, 30}},
+			min:   New(0, 1, 2),
+			max:   New(1, 1, 2),
+		},
+		{
+			name:  "crossing",
+			bound: geo.BoundWrapped{Min: geo.Point{170, -20}, Max: geo.Point{-170, -10}},
+			min:   New(3, 2, 2),
+			max:   New(0, 2, 2),
+		},
+		{
+			name:  "to 180",
+			bound: geo.BoundWrapped{Min: geo.Point{100, -20}, Max: geo.Point{180, -10}},
+			min:   New(3, 2, 2),
+			max:   New(3, 2, 2),
+		},
+		{
+			name:  "from 180",
+			bound: geo.BoundWrapped{Min: geo.Point{180, -20}, Max: geo.Point{-100, -10}},
+			min:   New(0, 2, 2),
+			max:   New(0, 2, 2),
+		},
+		{
+			name:  "crossing within one column",
+			bound: geo.BoundWrapped{Min: geo.Point{10, -20}, Max: geo.Point{5, -10}},
+			min:   New(2, 2, 2),
+			max:   New(1, 2, 2),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			min, max := BoundRange(tc.bound, 2)
+			if min != tc.min || max != tc.max {
+				t.Errorf("incorrect range: %v %v != %v %v", min, max, tc.min, tc.max)
+			}
+		})
+	}
+}
+
 func TestSharedParent(t *testing.T) {
 	p := geo.Point{-122.2711, 37.8044}
 	one := At(p, 15)
@@ -0,0 +1,306 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// CapStyle is the shape of the buffer at the ends of lines.
+type CapStyle int
+
+const (
+	CapRound  CapStyle = iota // a half circle around the end point
+	CapFlat                   // ends at the end point
+	CapSquare                 // a half square around the end point
+)
+
+// JoinStyle is the shape of the buffer on the outside of the corners of lines and rings.
+type JoinStyle int
+
+const (
+	JoinRound JoinStyle = iota // an arc around the corner
+	JoinMitre                  // the offset lines are extended until they meet
+	JoinBevel                  // the offset lines are connected by a straight line
+)
+
+// BufferOption is a possible parameter to Buffer.
+type BufferOption func(*bufferOptions)
+
+// Cap sets the shape at the ends of lines, the default is CapRound.
+// It also applies to points, where flat caps give an empty buffer.
+func Cap(style CapStyle) BufferOption {
+	return func(o *bufferOptions) {
+		o.cap = style
+	}
+}
+
+// Join sets the shape at the corners, the default is JoinRound.
+func Join(style JoinStyle) BufferOption {
+	return func(o *bufferOptions) {
+		o.join = style
+	}
+}
+
+// MitreLimit sets the ratio of the mitre length to the buffer distance
+// above which a mitre join is beveled instead, the default is 5.
+func MitreLimit(limit float64) BufferOption {
+	return func(o *bufferOptions) {
+		o.mitreLimit = limit
+	}
+}
+
+// QuadrantSegments sets the number of segments used to approximate
+// a quarter circle for round caps and joins, the default is 8.
+func QuadrantSegments(n int) BufferOption {
+	return func(o *bufferOptions) {
+		o.quadrantSegments = n
+	}
+}
+
+type bufferOptions struct {
+	cap              CapStyle
+	join             JoinStyle
+	mitreLimit       float64
+	quadrantSegments int
+}
+
+// Buffer returns the area within the distance of the geometry.
+// A negative distance shrinks the areal parts of the geometry, i.e. rings,
+// polygons and bounds, while points and lines are removed.
+// The result has counter-clockwise shells and clockwise holes.
+func Buffer(g geo.Geometry, distance float64, opts ...BufferOption) geo.MultiPolygon {
+	o := &bufferOptions{
+		cap:              CapRound,
+		join:             JoinRound,
+		mitreLimit:       5,
+		quadrantSegments: 8,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.quadrantSegments < 1 {
+		o.quadrantSegments = 1
+	}
+
+	if distance < 0 {
+		b := &bufferer{options: o, distance: -distance, areasOnly: true}
+		b.add(g)
+		return Difference(g, b.pieces)
+	}
+
+	b := &bufferer{options: o, distance: distance}
+	if distance > 0 {
+		b.add(g)
+	}
+
+	return Union(g, b.pieces)
+}
+
+// bufferer collects the polygons that together cover the
+// area within the distance of the boundaries, lines and points.
+// The result is their union with the areas of the geometry.
+type bufferer struct {
+	options   *bufferOptions
+	distance  float64
+	areasOnly bool
+	pieces    geo.MultiPolygon
+}
+
+func (b *bufferer) add(g geo.Geometry) {
+	switch g := g.(type) {
+	case nil:
+	case geo.Point:
+		if !b.areasOnly {
+			b.point(g)
+		}
+	case geo.MultiPoint:
+		if !b.areasOnly {
+			for _, p := range g {
+				b.point(p)
+			}
+		}
+	case geo.LineString:
+		if !b.areasOnly {
+			b.line(g, false)
+		}
+	case geo.MultiLineString:
+		if !b.areasOnly {
+			for _, ls := range g {
+				b.line(ls, false)
+			}
+		}
+	case geo.Ring:
+		b.line(geo.LineString(g), true)
+	case geo.Polygon:
+		for _, r := range g {
+			b.line(geo.LineString(r), true)
+		}
+	case geo.MultiPolygon:
+		for _, p := range g {
+			b.add(p)
+		}
+	case geo.Collection:
+		for _, c := range g {
+			b.add(c)
+		}
+	case geo.Bound:
+		if !g.IsEmpty() {
+			b.line(geo.LineString(g.ToRing()), true)
+		}
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (b *bufferer) point(p geo.Point) {
+	d := b.distance
+	switch b.options.cap {
+	case CapRound:
+		b.circle(p)
+	case CapSquare:
+		b.piece(
+			geo.Point{p[0] - d, p[1] - d},
+			geo.Point{p[0] + d, p[1] - d},
+			geo.Point{p[0] + d, p[1] + d},
+			geo.Point{p[0] - d, p[1] + d},
+		)
+	}
+}
+
+// line adds the pieces for each segment, joins at the vertices
+// and caps at the ends, closed lines have a join at the start instead.
+func (b *bufferer) line(ls geo.LineString, closed bool) {
+	points := make([]geo.Point, 0, len(ls))
+	for i, p := range ls {
+		if i == 0 || p != ls[i-1] {
+			points = append(points, p)
+		}
+	}
+
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+		closed = true
+	}
+
+	switch len(points) {
+	case 0:
+		return
+	case 1:
+		if !closed {
+			b.point(points[0])
+		}
+
+		return
+	}
+
+	n := len(points)
+	d := b.distance
+	for i := 0; i < n-1 || (closed && i < n); i++ {
+		p, q := points[i], points[(i+1)%n]
+		l := normal(p, q)
+		b.piece(
+			geo.Point{p[0] - l[0]*d, p[1] - l[1]*d},
+			geo.Point{q[0] - l[0]*d, q[1] - l[1]*d},
+			geo.Point{q[0] + l[0]*d, q[1] + l[1]*d},
+			geo.Point{p[0] + l[0]*d, p[1] + l[1]*d},
+		)
+	}
+
+	for i := 0; i < n; i++ {
+		if !closed && (i == 0 || i == n-1) {
+			continue
+		}
+
+		b.join(points[(i+n-1)%n], points[i], points[(i+1)%n])
+	}
+
+	if !closed {
+		b.cap(points[1], points[0])
+		b.cap(points[n-2], points[n-1])
+	}
+}
+
+// join adds the piece on the outside of the corner at v.
+func (b *bufferer) join(u, v, w geo.Point) {
+	turn := orient(u, v, w)
+	if turn == 0 && (v[0]-u[0])*(w[0]-v[0])+(v[1]-u[1])*(w[1]-v[1]) > 0 {
+		// straight through
+		return
+	}
+
+	if b.options.join == JoinRound {
+		b.circle(v)
+		return
+	}
+
+	// the outside is on the right of a left turn
+	side := 1.0
+	if turn > 0 {
+		side = -1
+	}
+
+	d := b.distance
+	n1, n2 := normal(u, v), normal(v, w)
+	p1 := geo.Point{v[0] + side*n1[0]*d, v[1] + side*n1[1]*d}
+	p2 := geo.Point{v[0] + side*n2[0]*d, v[1] + side*n2[1]*d}
+
+	if b.options.join == JoinMitre {
+		// the mitre point is on the bisector of the normals at distance
+		// d / cos(half the angle), i.e. v + d * (n1 + n2) / (1 + n1·n2)
+		cos := 1 + n1[0]*n2[0] + n1[1]*n2[1]
+		bisector := geo.Point{n1[0] + n2[0], n1[1] + n2[1]}
+		if cos > 0 && math.Hypot(bisector[0], bisector[1])/cos <= b.options.mitreLimit {
+			s := side * d / cos
+			m := geo.Point{v[0] + bisector[0]*s, v[1] + bisector[1]*s}
+			b.piece(v, p1, m, p2)
+			return
+		}
+	}
+
+	b.piece(v, p1, p2)
+}
+
+// cap adds the piece at the end point e of the line coming from p.
+func (b *bufferer) cap(p, e geo.Point) {
+	switch b.options.cap {
+	case CapRound:
+		b.circle(e)
+	case CapSquare:
+		d := b.distance
+		l := normal(p, e)
+		u := geo.Point{l[1] * d, -l[0] * d} // direction of the line
+		b.piece(
+			geo.Point{e[0] - l[0]*d, e[1] - l[1]*d},
+			geo.Point{e[0] - l[0]*d + u[0], e[1] - l[1]*d + u[1]},
+			geo.Point{e[0] + l[0]*d + u[0], e[1] + l[1]*d + u[1]},
+			geo.Point{e[0] + l[0]*d, e[1] + l[1]*d},
+		)
+	}
+}
+
+func (b *bufferer) circle(c geo.Point) {
+	n := 4 * b.options.quadrantSegments
+	r := make(geo.Ring, 0, n+1)
+	for i := 0; i < n; i++ {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		r = append(r, geo.Point{c[0] + b.distance*math.Cos(a), c[1] + b.distance*math.Sin(a)})
+	}
+
+	b.pieces = append(b.pieces, geo.Polygon{append(r, r[0])})
+}
+
+func (b *bufferer) piece(points ...geo.Point) {
+	r := make(geo.Ring, 0, len(points)+1)
+	r = append(r, points...)
+	b.pieces = append(b.pieces, geo.Polygon{append(r, points[0])})
+}
+
+// normal returns the unit vector to the left of the direction from p to q.
+func normal(p, q geo.Point) geo.Point {
+	dx, dy := q[0]-p[0], q[1]-p[1]
+	l := math.Hypot(dx, dy)
+	return geo.Point{-dy / l, dx / l}
+}
@@ -0,0 +1,164 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestBuffer(t *testing.T) {
+	// area of the 32-gon approximating the unit circle
+	circle := 16 * math.Sin(math.Pi/16)
+	square := geo.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	withHole := geo.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{3, 3}, {3, 7}, {7, 7}, {7, 3}, {3, 3}},
+	}
+
+	cases := []struct {
+		name     string
+		geom     geo.Geometry
+		distance float64
+		opts     []BufferOption
+		area     float64
+	}{
+		{
+			name:     "point",
+			geom:     geo.Point{1, 2},
+			distance: 1,
+			area:     circle,
+		},
+		{
+			name:     "point square cap",
+			geom:     geo.Point{1, 2},
+			distance: 1,
+			opts:     []BufferOption{Cap(CapSquare)},
+			area:     4,
+		},
+		{
+			name:     "point flat cap",
+			geom:     geo.Point{1, 2},
+			distance: 1,
+			opts:     []BufferOption{Cap(CapFlat)},
+			area:     0,
+		},
+		{
+			name:     "line round caps",
+			geom:     geo.LineString{{0, 0}, {5, 0}, {10, 0}},
+			distance: 1,
+			area:     20 + circle,
+		},
+		{
+			name:     "line flat caps",
+			geom:     geo.LineString{{0, 0}, {10, 0}},
+			distance: 1,
+			opts:     []BufferOption{Cap(CapFlat)},
+			area:     20,
+		},
+		{
+			name:     "line square caps",
+			geom:     geo.LineString{{0, 0}, {0, 10}},
+			distance: 1,
+			opts:     []BufferOption{Cap(CapSquare)},
+			area:     24,
+		},
+		{
+			name:     "line negative",
+			geom:     geo.LineString{{0, 0}, {10, 0}},
+			distance: -1,
+			area:     0,
+		},
+		{
+			name:     "polygon round joins",
+			geom:     square,
+			distance: 1,
+			area:     140 + circle,
+		},
+		{
+			name:     "polygon mitre joins",
+			geom:     square,
+			distance: 1,
+			opts:     []BufferOption{Join(JoinMitre)},
+			area:     144,
+		},
+		{
+			name:     "polygon bevel joins",
+			geom:     square,
+			distance: 1,
+			opts:     []BufferOption{Join(JoinBevel)},
+			area:     142,
+		},
+		{
+			name:     "polygon mitre limit",
+			geom:     square,
+			distance: 1,
+			opts:     []BufferOption{Join(JoinMitre), MitreLimit(1.2)},
+			area:     142,
+		},
+		{
+			name:     "polygon with hole",
+			geom:     withHole,
+			distance: 1,
+			opts:     []BufferOption{Join(JoinMitre)},
+			area:     140,
+		},
+		{
+			name:     "polygon negative",
+			geom:     square,
+			distance: -1,
+			area:     64,
+		},
+		{
+			name:     "polygon with hole negative",
+			geom:     withHole,
+			distance: -1,
+			opts:     []BufferOption{Join(JoinMitre)},
+			area:     28,
+		},
+		{
+			name:     "polygon collapses",
+			geom:     square,
+			distance: -6,
+			area:     0,
+		},
+		{
+			name:     "zero distance",
+			geom:     geo.Collection{square, geo.Point{20, 20}},
+			distance: 0,
+			area:     100,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Buffer(tc.geom, tc.distance, tc.opts...)
+			if a := Area(v); math.Abs(a-tc.area) > 1e-9 {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+
+			if err := Validate(v); err != nil {
+				t.Errorf("invalid result: %v", err)
+			}
+		})
+	}
+}
+
+func TestBuffer_closedLine(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	v := Buffer(ls, 1, Join(JoinMitre))
+	if len(v) != 1 || len(v[0]) != 2 {
+		t.Fatalf("expected a polygon with a hole: %v", v)
+	}
+
+	if a := Area(v); math.Abs(a-80) > 1e-9 {
+		t.Errorf("incorrect area: %v != %v", a, 80)
+	}
+}
+
+func TestBuffer_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Buffer(g, 1)
+		Buffer(g, -1)
+	}
+}
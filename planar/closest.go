@@ -0,0 +1,23 @@
+package planar
+
+import (
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/closest"
+)
+
+var planarMetric = closest.Metric{
+	Segment: planarSegment,
+	Intersect: func(a1, a2, b1, b2 geo.Point) (geo.Point, bool) {
+		kind, p := segmentIntersection(a1, a2, b1, b2)
+		return p, kind != noIntersection
+	},
+	Contains: PolygonContains,
+}
+
+// ClosestPoints returns the closest points of the two geometries and the distance
+// between them. Areas include their interior, so if one geometry intersects or is inside
+// the other the points are a common point and the distance is 0.
+// If either geometry is empty the distance is +Inf.
+func ClosestPoints(a, b geo.Geometry) (pa, pb geo.Point, dist float64) {
+	return closest.Points(a, b, planarMetric)
+}
@@ -0,0 +1,132 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestClosestPoints(t *testing.T) {
+	square := geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	cases := []struct {
+		name   string
+		a, b   geo.Geometry
+		pa, pb geo.Point
+		dist   float64
+	}{
+		{
+			name: "points",
+			a:    geo.Point{0, 0},
+			b:    geo.MultiPoint{{3, 4}, {6, 8}},
+			pa:   geo.Point{0, 0},
+			pb:   geo.Point{3, 4},
+			dist: 5,
+		},
+		{
+			name: "point and line",
+			a:    geo.Point{1, 1},
+			b:    geo.LineString{{0, 0}, {2, 0}},
+			pa:   geo.Point{1, 1},
+			pb:   geo.Point{1, 0},
+			dist: 1,
+		},
+		{
+			name: "parallel lines",
+			a:    geo.LineString{{0, 0}, {4, 0}},
+			b:    geo.LineString{{5, 2}, {8, 2}},
+			pa:   geo.Point{4, 0},
+			pb:   geo.Point{5, 2},
+			dist: math.Sqrt(5),
+		},
+		{
+			name: "line end near a segment",
+			a:    geo.LineString{{2, 3}, {2, 1}},
+			b:    geo.LineString{{0, 0}, {4, 0}},
+			pa:   geo.Point{2, 1},
+			pb:   geo.Point{2, 0},
+			dist: 1,
+		},
+		{
+			name: "crossing lines",
+			a:    geo.LineString{{0, 0}, {2, 2}},
+			b:    geo.LineString{{0, 2}, {2, 0}},
+			pa:   geo.Point{1, 1},
+			pb:   geo.Point{1, 1},
+		},
+		{
+			name: "point inside polygon",
+			a:    square,
+			b:    geo.Point{1, 2},
+			pa:   geo.Point{1, 2},
+			pb:   geo.Point{1, 2},
+		},
+		{
+			name: "polygon inside polygon",
+			a:    geo.Polygon{{{1, 1}, {2, 1}, {2, 2}, {1, 1}}},
+			b:    square,
+			pa:   geo.Point{1, 1},
+			pb:   geo.Point{1, 1},
+		},
+		{
+			name: "point in hole",
+			a: geo.Polygon{
+				square[0],
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:    geo.Point{2, 2.5},
+			pa:   geo.Point{2, 3},
+			pb:   geo.Point{2, 2.5},
+			dist: 0.5,
+		},
+		{
+			name: "polygon and bound",
+			a:    square,
+			b:    geo.Bound{Min: geo.Point{6, 1}, Max: geo.Point{7, 2}},
+			pa:   geo.Point{4, 1},
+			pb:   geo.Point{6, 1},
+			dist: 2,
+		},
+		{
+			name: "collection",
+			a:    geo.Collection{geo.Point{10, 10}, geo.LineString{{-1, -1}, {-1, -5}}},
+			b:    square,
+			pa:   geo.Point{-1, -1},
+			pb:   geo.Point{0, 0},
+			dist: math.Sqrt2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pa, pb, d := ClosestPoints(tc.a, tc.b)
+			if pa != tc.pa || pb != tc.pb || math.Abs(d-tc.dist) > 1e-12 {
+				t.Errorf("incorrect result: %v %v %v", pa, pb, d)
+			}
+
+			// the order of the geometries does not matter
+			pb, pa, d = ClosestPoints(tc.b, tc.a)
+			if math.Abs(d-tc.dist) > 1e-12 {
+				t.Errorf("incorrect reversed distance: %v", d)
+			}
+
+			if math.Abs(Distance(pa, pb)-d) > 1e-12 {
+				t.Errorf("distance does not match the points: %v %v %v", pa, pb, d)
+			}
+		})
+	}
+}
+
+func TestClosestPoints_empty(t *testing.T) {
+	if _, _, d := ClosestPoints(geo.Point{}, geo.MultiPoint{}); !math.IsInf(d, 1) {
+		t.Errorf("incorrect distance: %v", d)
+	}
+}
+
+func TestClosestPoints_allGeometries(t *testing.T) {
+	for _, a := range geo.AllGeometries {
+		for _, b := range geo.AllGeometries {
+			ClosestPoints(a, b)
+		}
+	}
+}
@@ -44,3 +44,112 @@ func ExampleLength() {
 	// Output:
 	// 12
 }
+
+func ExampleIntersection() {
+	a := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	b := geo.Bound{Min: geo.Point{1, 1}, Max: geo.Point{3, 3}}
+	mp := planar.Intersection(a, b)
+
+	fmt.Println(mp)
+	// Output:
+	// [[[[1 1] [2 1] [2 2] [1 2] [1 1]]]]
+}
+
+func ExampleRelate() {
+	a := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	b := geo.LineString{{-1, 1}, {3, 1}}
+
+	fmt.Println(planar.Relate(a, b), planar.Crosses(a, b))
+	// Output:
+	// 1F20F1102 true
+}
+
+func ExampleValidate() {
+	bowtie := geo.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}
+	err := planar.Validate(bowtie)
+
+	fmt.Println(err)
+	// Output:
+	// planar: invalid geometry, ring self-intersection at [1 1]
+}
+
+func ExampleMakeValid() {
+	bowtie := geo.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}
+	fixed := planar.MakeValid(bowtie)
+
+	fmt.Println(fixed)
+	// Output:
+	// [[[[0 0] [1 1] [0 2] [0 0]]] [[[1 1] [2 0] [2 2] [1 1]]]]
+}
+
+func ExampleConvexHull() {
+	mp := geo.MultiPoint{{0, 0}, {1, 1}, {2, 0}, {2, 2}, {0, 2}, {1, 0.5}}
+	hull := planar.ConvexHull(mp)
+
+	fmt.Println(hull)
+	// Output:
+	// [[[0 0] [2 0] [2 2] [0 2] [0 0]]]
+}
+
+func ExampleBuffer() {
+	square := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	b := planar.Buffer(square, 1, planar.Join(planar.JoinMitre))
+
+	fmt.Println(b)
+	// Output:
+	// [[[[-1 -1] [3 -1] [3 3] [-1 3] [-1 -1]]]]
+}
+
+func ExamplePoleOfInaccessibility() {
+	// the centroid of this C shape is in the notch
+	c := geo.Polygon{{
+		{0, 0}, {10, 0}, {10, 3}, {3, 3}, {3, 7},
+		{10, 7}, {10, 10}, {0, 10}, {0, 0},
+	}}
+
+	p, d := planar.PoleOfInaccessibility(c, 0.01)
+	fmt.Printf("%.2f %.2f\n", p, d)
+	fmt.Println(planar.PointOnSurface(c))
+	// Output:
+	// [1.76 1.76] 1.76
+	// [1.5 5]
+}
+
+func ExampleLineStringIntersections() {
+	a := geo.LineString{{0, 0}, {2, 0}, {2, 2}}
+	b := geo.LineString{{1, -1}, {1, 1}, {3, 1}}
+
+	is := planar.LineStringIntersections(a, b)
+	for _, i := range is {
+		fmt.Println(i.A, i.B, i.Point)
+	}
+
+	fmt.Println(planar.SelfIntersections(geo.LineString{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}))
+	// Output:
+	// 0 0 [1 0]
+	// 1 1 [2 1]
+	// [{0 2 [1 1] [1 1]}]
+}
+
+func ExampleLocatePoint() {
+	road := geo.LineString{{0, 0}, {4, 0}, {4, 3}, {1, 3}}
+
+	fraction, distance := planar.LocatePoint(road, geo.Point{5, 1})
+	fmt.Println(fraction, distance)
+	fmt.Println(planar.InterpolatePoint(road, 0.8))
+	fmt.Println(planar.Substring(road, 0.2, 0.5))
+	// Output:
+	// 0.5 5
+	// [3 3]
+	// [[2 0] [4 0] [4 1]]
+}
+
+func ExampleClosestPoints() {
+	building := geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	road := geo.LineString{{8, -2}, {6, 2}, {8, 6}}
+
+	pa, pb, d := planar.ClosestPoints(building, road)
+	fmt.Println(pa, pb, d)
+	// Output:
+	// [4 2] [6 2] 2
+}
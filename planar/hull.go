@@ -0,0 +1,306 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pchchv/geo"
+)
+
+// ConvexHull returns the smallest convex polygon that contains all the points
+// of the geometry, computed using the monotone chain algorithm.
+// The ring is counter-clockwise and closed. For degenerate inputs a line string
+// between the extreme points is returned if all the points are collinear,
+// a point if there is only one distinct point and nil if there are no points.
+// To get the hull of a feature collection pass the geometries as a geo.Collection.
+func ConvexHull(g geo.Geometry) geo.Geometry {
+	return hullGeometry(convexHull(hullPoints(g)))
+}
+
+// ConcaveHull returns a polygon that contains all the points of the geometry
+// and follows them more closely than the convex hull. Starting from the convex hull,
+// an edge is replaced by two edges through the nearest point inside the hull,
+// as long as that point is close enough relative to the length of the edge.
+// Concavity is that relative measure, it must be positive. Small values, e.g. 1,
+// give a detailed shape and large values approach the convex hull.
+// Edges shorter than the length threshold are not split.
+// Degenerate inputs fall back in the same way as ConvexHull.
+func ConcaveHull(g geo.Geometry, concavity, lengthThreshold float64) geo.Geometry {
+	if !(concavity > 0) {
+		panic(fmt.Sprintf("concavity must be positive: %v", concavity))
+	}
+
+	points := hullPoints(g)
+	hull := convexHull(points)
+	if len(hull) < 3 {
+		return hullGeometry(hull)
+	}
+
+	return hullGeometry(concaveHull(points, hull, concavity, lengthThreshold))
+}
+
+// hullPoints returns the distinct points of the geometry sorted by x then y.
+func hullPoints(g geo.Geometry) []geo.Point {
+	var points []geo.Point
+	var collect func(g geo.Geometry)
+	collect = func(g geo.Geometry) {
+		switch g := g.(type) {
+		case nil:
+		case geo.Point:
+			points = append(points, g)
+		case geo.MultiPoint:
+			points = append(points, g...)
+		case geo.LineString:
+			points = append(points, g...)
+		case geo.MultiLineString:
+			for _, ls := range g {
+				points = append(points, ls...)
+			}
+		case geo.Ring:
+			points = append(points, g...)
+		case geo.Polygon:
+			// only the shell is on the hull
+			if len(g) > 0 {
+				points = append(points, g[0]...)
+			}
+		case geo.MultiPolygon:
+			for _, p := range g {
+				collect(p)
+			}
+		case geo.Collection:
+			for _, c := range g {
+				collect(c)
+			}
+		case geo.Bound:
+			if !g.IsEmpty() {
+				points = append(points, g.Min, g.Max, g.LeftTop(), g.RightBottom())
+			}
+		default:
+			panic(fmt.Sprintf("geometry type not supported: %T", g))
+		}
+	}
+
+	collect(g)
+	sort.Slice(points, func(i, j int) bool {
+		return lessPoint(points[i], points[j])
+	})
+
+	unique := points[:0]
+	for i, p := range points {
+		if i == 0 || p != points[i-1] {
+			unique = append(unique, p)
+		}
+	}
+
+	return unique
+}
+
+// convexHull returns the counter-clockwise hull of the sorted distinct points
+// without collinear points or the closing point.
+func convexHull(points []geo.Point) []geo.Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	hull := make([]geo.Point, 0, 2*len(points))
+	// lower hull
+	for _, p := range points {
+		for len(hull) >= 2 && orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, p)
+	}
+
+	// upper hull
+	lower := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		p := points[i]
+		for len(hull) >= lower && orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, p)
+	}
+
+	// the last point is the first one again
+	return hull[:len(hull)-1]
+}
+
+func hullGeometry(hull []geo.Point) geo.Geometry {
+	switch len(hull) {
+	case 0:
+		return nil
+	case 1:
+		return hull[0]
+	case 2:
+		return geo.LineString{hull[0], hull[1]}
+	}
+
+	r := make(geo.Ring, 0, len(hull)+1)
+	r = append(r, hull...)
+	return geo.Polygon{append(r, hull[0])}
+}
+
+type hullNode struct {
+	p          geo.Point
+	prev, next *hullNode
+}
+
+// concaveHull digs into the convex hull, it follows the "concaveman"
+// algorithm by Park and Oh with a linear search for the candidate points.
+func concaveHull(points, convex []geo.Point, concavity, lengthThreshold float64) []geo.Point {
+	onHull := make(map[geo.Point]bool, len(convex))
+	for _, p := range convex {
+		onHull[p] = true
+	}
+
+	var inner []geo.Point
+	for _, p := range points {
+		if !onHull[p] {
+			inner = append(inner, p)
+		}
+	}
+
+	var head, last *hullNode
+	queue := make([]*hullNode, 0, len(convex))
+	for _, p := range convex {
+		n := &hullNode{p: p, prev: last}
+		if last == nil {
+			head = n
+		} else {
+			last.next = n
+		}
+
+		last = n
+		queue = append(queue, n)
+	}
+
+	last.next, head.prev = head, last
+
+	used := make([]bool, len(inner))
+	sqConcavity := concavity * concavity
+	sqLengthThreshold := lengthThreshold * lengthThreshold
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		a, b := n.p, n.next.p
+		sqLen := DistanceSquared(a, b)
+		if sqLen < sqLengthThreshold {
+			continue
+		}
+
+		// points on the edge become vertices, digging past them would leave them outside
+		if i := hullSplit(inner, used, a, b); i >= 0 {
+			used[i] = true
+			c := &hullNode{p: inner[i], prev: n, next: n.next}
+			n.next.prev = c
+			n.next = c
+			queue = append(queue, n, c)
+			continue
+		}
+
+		maxSqLen := sqLen / sqConcavity
+		i := hullCandidate(head, inner, used, n, maxSqLen)
+		if i < 0 {
+			continue
+		}
+
+		p := inner[i]
+		if math.Min(DistanceSquared(p, a), DistanceSquared(p, b)) > maxSqLen {
+			continue
+		}
+
+		used[i] = true
+		c := &hullNode{p: p, prev: n, next: n.next}
+		n.next.prev = c
+		n.next = c
+		queue = append(queue, n, c)
+	}
+
+	hull := []geo.Point{head.p}
+	for n := head.next; n != head; n = n.next {
+		hull = append(hull, n.p)
+	}
+
+	return hull
+}
+
+// hullSplit returns the index of the point on the edge closest to its start.
+// Returns -1 if there is no such point.
+func hullSplit(inner []geo.Point, used []bool, a, b geo.Point) int {
+	index, min := -1, math.Inf(1)
+	for i, p := range inner {
+		if used[i] || !onSegmentExact(a, b, p) {
+			continue
+		}
+
+		if d := DistanceSquared(a, p); d < min {
+			index, min = i, d
+		}
+	}
+
+	return index
+}
+
+// hullCandidate returns the index of the point closest to the edge starting at n that
+// is closer to it than to the adjacent edges and can be connected to the edge
+// without crossing the hull. Points on the edge are not candidates.
+// Returns -1 if there is no such point.
+func hullCandidate(head *hullNode, inner []geo.Point, used []bool, n *hullNode, maxSqDist float64) int {
+	a, b := n.p, n.next.p
+	type candidate struct {
+		index int
+		dist  float64
+	}
+
+	var candidates []candidate
+	for i, p := range inner {
+		if used[i] {
+			continue
+		}
+
+		if d := DistanceFromSegmentSquared(a, b, p); d > 0 && d <= maxSqDist {
+			candidates = append(candidates, candidate{index: i, dist: d})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+
+	for _, c := range candidates {
+		p := inner[c.index]
+		if c.dist < DistanceFromSegmentSquared(n.prev.p, a, p) &&
+			c.dist < DistanceFromSegmentSquared(b, n.next.next.p, p) &&
+			!crossesHull(head, a, p) &&
+			!crossesHull(head, b, p) {
+			return c.index
+		}
+	}
+
+	return -1
+}
+
+// crossesHull returns true if the segment from the hull vertex a to b touches
+// or overlaps an edge of the hull anywhere but at a.
+func crossesHull(head *hullNode, a, b geo.Point) bool {
+	n := head
+	for {
+		p, q := n.p, n.next.p
+		switch kind, at := segmentIntersection(a, b, p, q); kind {
+		case collinearIntersection:
+			return true
+		case pointIntersection:
+			if at != a || (p != a && q != a) {
+				return true
+			}
+		}
+
+		if n = n.next; n == head {
+			return false
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestConvexHull(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   geo.Geometry
+		result geo.Geometry
+	}{
+		{
+			name: "points in a square",
+			geom: geo.MultiPoint{{0, 0}, {1, 1}, {2, 0}, {1, 0}, {2, 2}, {0, 2}, {0.5, 1.5}},
+			result: geo.Polygon{
+				{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}},
+			},
+		},
+		{
+			name: "polygon holes are ignored",
+			geom: geo.Polygon{
+				{{0, 0}, {4, 0}, {2, 3}, {0, 0}},
+				{{1, 1}, {2, 2}, {3, 1}, {1, 1}},
+			},
+			result: geo.Polygon{
+				{{0, 0}, {4, 0}, {2, 3}, {0, 0}},
+			},
+		},
+		{
+			name:   "collinear",
+			geom:   geo.LineString{{1, 1}, {0, 0}, {3, 3}, {2, 2}},
+			result: geo.LineString{{0, 0}, {3, 3}},
+		},
+		{
+			name:   "single point",
+			geom:   geo.MultiPoint{{1, 2}, {1, 2}},
+			result: geo.Point{1, 2},
+		},
+		{
+			name:   "empty",
+			geom:   geo.MultiPoint{},
+			result: nil,
+		},
+		{
+			name: "collection",
+			geom: geo.Collection{geo.Point{0, 0}, geo.Bound{Min: geo.Point{1, 1}, Max: geo.Point{2, 2}}},
+			result: geo.Polygon{
+				{{0, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 0}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := ConvexHull(tc.geom)
+			if tc.result == nil {
+				if v != nil {
+					t.Errorf("expected nil: %v", v)
+				}
+
+				return
+			}
+
+			if !geo.Equal(v, tc.result) {
+				t.Errorf("incorrect hull: %v != %v", v, tc.result)
+			}
+		})
+	}
+
+	for _, g := range geo.AllGeometries {
+		// should not panic with unsupported type
+		ConvexHull(g)
+		ConcaveHull(g, 2, 0)
+	}
+}
+
+func TestConcaveHull(t *testing.T) {
+	// points on a grid in the shape of a C
+	var mp geo.MultiPoint
+	for x := 0; x <= 10; x++ {
+		for y := 0; y <= 10; y++ {
+			if x > 2 && y > 2 && y < 8 {
+				continue
+			}
+
+			mp = append(mp, geo.Point{float64(x), float64(y)})
+		}
+	}
+
+	convex := ConvexHull(mp).(geo.Polygon)
+	concave := ConcaveHull(mp, 0.5, 0).(geo.Polygon)
+
+	if err := Validate(concave); err != nil {
+		t.Fatalf("hull should be valid: %v", err)
+	}
+
+	if o := concave[0].Orientation(); o != geo.CCW {
+		t.Errorf("hull should be counter clockwise")
+	}
+
+	if a, c := Area(concave), Area(convex); a >= c {
+		t.Errorf("concave hull should be smaller: %v >= %v", a, c)
+	}
+
+	if a := Area(concave); math.Abs(a-(100-48)) > 1e-9 {
+		t.Errorf("hull should follow the gap: %v", a)
+	}
+
+	for _, p := range mp {
+		if !Covers(concave, p) {
+			t.Errorf("point not in hull: %v", p)
+		}
+	}
+
+	// a large concavity gives the convex hull
+	if v := ConcaveHull(mp, 100, 0); Area(v) != Area(convex) {
+		t.Errorf("should be the convex hull: %v", v)
+	}
+
+	// edges shorter than the threshold are not split
+	if v := ConcaveHull(mp, 0.5, 20); Area(v) != Area(convex) {
+		t.Errorf("should be the convex hull: %v", v)
+	}
+
+	for _, concavity := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("should panic for concavity: %v", concavity)
+				}
+			}()
+			ConcaveHull(mp, concavity, 0)
+		}()
+	}
+}
+
+func TestConcaveHull_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 10; i++ {
+		mp := make(geo.MultiPoint, 200)
+		for j := range mp {
+			mp[j] = geo.Point{r.Float64(), r.Float64()}
+		}
+
+		hull := ConcaveHull(mp, 1.5, 0)
+		if err := Validate(hull); err != nil {
+			t.Fatalf("%d: hull should be valid: %v", i, err)
+		}
+
+		for _, p := range mp {
+			if !Covers(hull, p) {
+				t.Errorf("%d: point not in hull: %v", i, p)
+			}
+		}
+	}
+}
+
+func TestConcaveHull_collinear(t *testing.T) {
+	hull := ConcaveHull(geo.MultiPoint{{0, 2}, {4, 2}, {5, 3}, {2, 2}, {5, 1}}, 1, 0)
+	if err := Validate(hull); err != nil {
+		t.Errorf("hull should be valid: %v: %v", err, hull)
+	}
+
+	// points on a small grid have many collinear triples
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		mp := make(geo.MultiPoint, 3+r.Intn(12))
+		for j := range mp {
+			mp[j] = geo.Point{float64(r.Intn(6)), float64(r.Intn(6))}
+		}
+
+		for _, concavity := range []float64{0.5, 1, 2} {
+			hull := ConcaveHull(mp, concavity, 0)
+			if _, ok := hull.(geo.Polygon); !ok {
+				continue
+			}
+
+			if err := Validate(hull); err != nil {
+				t.Fatalf("%d: hull should be valid: %v: %v %v", i, err, mp, hull)
+			}
+
+			for _, p := range mp {
+				if !Covers(hull, p) {
+					t.Fatalf("%d: point not in hull: %v: %v", i, p, hull)
+				}
+			}
+		}
+	}
+}
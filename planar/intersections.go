@@ -0,0 +1,307 @@
+package planar
+
+import (
+	"sort"
+
+	"github.com/pchchv/geo"
+)
+
+// SegmentIntersection is where two segments, A and B, intersect.
+// If the segments are collinear and overlap, they intersect
+// along the segment from Point to End, otherwise End equals Point.
+type SegmentIntersection struct {
+	A, B  int
+	Point geo.Point
+	End   geo.Point
+}
+
+// Overlap returns true if the segments overlap instead of meeting at a point.
+func (si SegmentIntersection) Overlap() bool {
+	return si.Point != si.End
+}
+
+// SegmentIntersections returns all the intersections between the segments, A and B
+// are their indexes with A < B. It sorts the segments by x and only tests those
+// whose x and y ranges overlap, which is still O(n²) in the worst case.
+// The result is sorted by A then B.
+func SegmentIntersections(segs [][2]geo.Point) []SegmentIntersection {
+	return sweepIntersections(segs, func(i, j int) bool { return true })
+}
+
+// LineStringIntersections returns the intersections between the segments of the two
+// line strings, A is the index of the segment of a, B the index of the segment of b.
+// Segment i is from point i to point i+1.
+func LineStringIntersections(a, b geo.LineString) []SegmentIntersection {
+	segs := lineSegmentPoints(a)
+	na := len(segs)
+	segs = append(segs, lineSegmentPoints(b)...)
+	result := sweepIntersections(segs, func(i, j int) bool {
+		return i < na && j >= na
+	})
+
+	for i := range result {
+		result[i].B -= na
+	}
+
+	return result
+}
+
+// SelfIntersections returns the intersections between the segments of the line string,
+// ignoring the vertex shared by consecutive segments. Use geo.LineString(ring) to check
+// a ring, for closed lines the last and the first segment are consecutive too.
+// A simple line or ring has no self intersections.
+func SelfIntersections(ls geo.LineString) []SegmentIntersection {
+	segs := lineSegmentPoints(ls)
+	prev := previousSegments(segs, 0)
+	result := sweepIntersections(segs, func(i, j int) bool {
+		return segs[i][0] != segs[i][1] && segs[j][0] != segs[j][1]
+	})
+
+	// the first and the last non zero length segments of a closed line
+	first, last := -1, -1
+	for i, s := range segs {
+		if s[0] != s[1] {
+			if first == -1 {
+				first = i
+			}
+
+			last = i
+		}
+	}
+
+	closed := len(ls) > 2 && ls[0] == ls[len(ls)-1]
+	n := 0
+	for _, si := range result {
+		if sharedVertex(segs, prev, si) ||
+			(closed && !si.Overlap() && si.A == first && si.B == last && si.Point == ls[0]) {
+			continue
+		}
+
+		result[n] = si
+		n++
+	}
+
+	return result[:n]
+}
+
+// IntersectionCollection returns the intersection points and overlaps as a collection
+// of geo.Point and geo.LineString, each included once.
+func IntersectionCollection(intersections []SegmentIntersection) geo.Collection {
+	var (
+		c      geo.Collection
+		points = make(map[geo.Point]bool)
+		lines  = make(map[[2]geo.Point]bool)
+	)
+
+	for _, si := range intersections {
+		if !si.Overlap() {
+			if !points[si.Point] {
+				points[si.Point] = true
+				c = append(c, si.Point)
+			}
+
+			continue
+		}
+
+		key := [2]geo.Point{si.Point, si.End}
+		if lessPoint(si.End, si.Point) {
+			key = [2]geo.Point{si.End, si.Point}
+		}
+
+		if !lines[key] {
+			lines[key] = true
+			c = append(c, geo.LineString{si.Point, si.End})
+		}
+	}
+
+	return c
+}
+
+// NodeLines splits the lines of the multi line string at all the points where
+// they intersect each other or themselves, so that lines only meet at their end points.
+// Overlapping parts are split at both ends of the overlap.
+func NodeLines(mls geo.MultiLineString) geo.MultiLineString {
+	var (
+		segs  [][2]geo.Point
+		prev  []int
+		start = make([]int, len(mls)) // the index of the first segment of each line
+	)
+
+	for i, ls := range mls {
+		start[i] = len(segs)
+		ss := lineSegmentPoints(ls)
+		prev = append(prev, previousSegments(ss, len(segs))...)
+		segs = append(segs, ss...)
+	}
+
+	nodes := make([][]geo.Point, len(segs))
+	for _, si := range SegmentIntersections(segs) {
+		if sharedVertex(segs, prev, si) {
+			continue
+		}
+
+		for _, s := range [2]int{si.A, si.B} {
+			nodes[s] = append(nodes[s], si.Point)
+			if si.Overlap() {
+				nodes[s] = append(nodes[s], si.End)
+			}
+		}
+	}
+
+	result := make(geo.MultiLineString, 0, len(mls))
+	for i, ls := range mls {
+		if len(ls) < 2 {
+			result = append(result, append(geo.LineString(nil), ls...))
+			continue
+		}
+
+		pieces := len(result)
+		current := geo.LineString{ls[0]}
+		for j := start[i]; j < start[i]+len(ls)-1; j++ {
+			s, ns := segs[j], nodes[j]
+			sort.Slice(ns, func(a, b int) bool {
+				return DistanceSquared(s[0], ns[a]) < DistanceSquared(s[0], ns[b])
+			})
+
+			for _, p := range ns {
+				if p != current[len(current)-1] {
+					current = append(current, p)
+				}
+
+				if len(current) > 1 {
+					result = append(result, current)
+					current = geo.LineString{p}
+				}
+			}
+
+			if s[1] != current[len(current)-1] {
+				current = append(current, s[1])
+			}
+		}
+
+		if len(current) > 1 || len(result) == pieces {
+			result = append(result, current)
+		}
+	}
+
+	return result
+}
+
+// previousSegments returns the index of the previous non zero length segment of the line
+// for each segment, or -1 for the first, offset by the index of the first segment.
+func previousSegments(segs [][2]geo.Point, offset int) []int {
+	prev := make([]int, len(segs))
+	last := -1
+	for i, s := range segs {
+		prev[i] = last
+		if s[0] != s[1] {
+			last = offset + i
+		}
+	}
+
+	return prev
+}
+
+// sharedVertex returns true if the segments are consecutive in a line
+// and only intersect at their common vertex.
+func sharedVertex(segs [][2]geo.Point, prev []int, si SegmentIntersection) bool {
+	return !si.Overlap() && prev[si.B] == si.A && si.Point == segs[si.B][0]
+}
+
+// lineSegmentPoints returns the segments of the line string.
+func lineSegmentPoints(ls geo.LineString) [][2]geo.Point {
+	if len(ls) < 2 {
+		return nil
+	}
+
+	segs := make([][2]geo.Point, 0, len(ls)-1)
+	for i := 1; i < len(ls); i++ {
+		segs = append(segs, [2]geo.Point{ls[i-1], ls[i]})
+	}
+
+	return segs
+}
+
+// sweepIntersections intersects the pairs of segments, i < j, accepted by the filter.
+func sweepIntersections(segs [][2]geo.Point, filter func(i, j int) bool) []SegmentIntersection {
+	bounds := make([]geo.Bound, len(segs))
+	for i, s := range segs {
+		bounds[i] = segmentBound(s[0], s[1])
+	}
+
+	var result []SegmentIntersection
+	sweepPairs(bounds, func(i, j int) {
+		if !filter(i, j) {
+			return
+		}
+
+		if si, ok := intersectSegmentPair(segs[i], segs[j]); ok {
+			si.A, si.B = i, j
+			result = append(result, si)
+		}
+	})
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].A != result[j].A {
+			return result[i].A < result[j].A
+		}
+
+		return result[i].B < result[j].B
+	})
+
+	return result
+}
+
+// sweepPairs calls the function for every pair of indexes, i < j, of the bounds
+// whose x and y ranges overlap. It sorts the bounds by their min x and compares
+// each one with the following ones until their min x passes its max x.
+// This sort and prune sweep skips most pairs of spread out segments,
+// but it is still O(n²) when many x ranges overlap.
+func sweepPairs(bounds []geo.Bound, fn func(i, j int)) {
+	order := make([]int, len(bounds))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return bounds[order[i]].Min[0] < bounds[order[j]].Min[0]
+	})
+
+	for k, i := range order {
+		b1 := bounds[i]
+		for _, j := range order[k+1:] {
+			b2 := bounds[j]
+			if b2.Min[0] > b1.Max[0] {
+				break
+			}
+
+			if b2.Max[1] < b1.Min[1] || b2.Min[1] > b1.Max[1] {
+				continue
+			}
+
+			if i < j {
+				fn(i, j)
+			} else {
+				fn(j, i)
+			}
+		}
+	}
+}
+
+// segmentBound returns the bound of the segment [a, b].
+func segmentBound(a, b geo.Point) geo.Bound {
+	return geo.Bound{Min: a, Max: a}.Extend(b)
+}
+
+func intersectSegmentPair(s1, s2 [2]geo.Point) (SegmentIntersection, bool) {
+	kind, p := segmentIntersection(s1[0], s1[1], s2[0], s2[1])
+	switch kind {
+	case noIntersection:
+		return SegmentIntersection{}, false
+	case collinearIntersection:
+		_, start, end := collinearOverlap(s1[0], s1[1], s2[0], s2[1])
+		return SegmentIntersection{Point: start, End: end}, true
+	}
+
+	return SegmentIntersection{Point: p, End: p}, true
+}
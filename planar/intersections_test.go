@@ -0,0 +1,232 @@
+package planar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestSegmentIntersections(t *testing.T) {
+	segs := [][2]geo.Point{
+		{{0, 0}, {2, 2}},
+		{{0, 2}, {2, 0}},
+		{{5, 5}, {6, 6}},
+		{{1, 1}, {3, 3}},
+	}
+
+	expected := []SegmentIntersection{
+		{A: 0, B: 1, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+		{A: 0, B: 3, Point: geo.Point{1, 1}, End: geo.Point{2, 2}},
+		{A: 1, B: 3, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+	}
+
+	if result := SegmentIntersections(segs); !reflect.DeepEqual(result, expected) {
+		t.Errorf("incorrect intersections: %v", result)
+	}
+}
+
+func TestLineStringIntersections(t *testing.T) {
+	cases := []struct {
+		name   string
+		a, b   geo.LineString
+		result []SegmentIntersection
+	}{
+		{
+			name: "cross",
+			a:    geo.LineString{{0, 0}, {2, 0}, {2, 2}},
+			b:    geo.LineString{{1, -1}, {1, 1}, {3, 1}},
+			result: []SegmentIntersection{
+				{A: 0, B: 0, Point: geo.Point{1, 0}, End: geo.Point{1, 0}},
+				{A: 1, B: 1, Point: geo.Point{2, 1}, End: geo.Point{2, 1}},
+			},
+		},
+		{
+			name: "touch at vertex",
+			a:    geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:    geo.LineString{{1, 1}, {1, 2}},
+			result: []SegmentIntersection{
+				{A: 0, B: 0, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+				{A: 1, B: 0, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+			},
+		},
+		{
+			name: "overlap",
+			a:    geo.LineString{{0, 0}, {4, 0}},
+			b:    geo.LineString{{5, 0}, {2, 0}, {2, 3}},
+			result: []SegmentIntersection{
+				{A: 0, B: 0, Point: geo.Point{2, 0}, End: geo.Point{4, 0}},
+				{A: 0, B: 1, Point: geo.Point{2, 0}, End: geo.Point{2, 0}},
+			},
+		},
+		{
+			name: "disjoint",
+			a:    geo.LineString{{0, 0}, {1, 0}},
+			b:    geo.LineString{{0, 1}, {1, 1}},
+		},
+		{
+			name: "empty",
+			a:    geo.LineString{{0, 0}, {1, 0}},
+			b:    geo.LineString{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := LineStringIntersections(tc.a, tc.b)
+			if !reflect.DeepEqual(result, tc.result) {
+				t.Errorf("incorrect intersections: %v", result)
+			}
+		})
+	}
+}
+
+func TestSelfIntersections(t *testing.T) {
+	cases := []struct {
+		name   string
+		line   geo.LineString
+		result []SegmentIntersection
+	}{
+		{
+			name: "simple line",
+			line: geo.LineString{{0, 0}, {1, 0}, {1, 1}, {0, 1}},
+		},
+		{
+			name: "simple ring",
+			line: geo.LineString{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+		},
+		{
+			name: "repeated point",
+			line: geo.LineString{{0, 0}, {1, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}, {0, 0}},
+		},
+		{
+			name: "bow tie",
+			line: geo.LineString{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}},
+			result: []SegmentIntersection{
+				{A: 0, B: 2, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+			},
+		},
+		{
+			name: "ring touching itself",
+			line: geo.LineString{{0, 0}, {4, 0}, {2, 2}, {4, 4}, {0, 4}, {2, 2}, {0, 0}},
+			result: []SegmentIntersection{
+				{A: 1, B: 4, Point: geo.Point{2, 2}, End: geo.Point{2, 2}},
+				{A: 1, B: 5, Point: geo.Point{2, 2}, End: geo.Point{2, 2}},
+				{A: 2, B: 4, Point: geo.Point{2, 2}, End: geo.Point{2, 2}},
+				{A: 2, B: 5, Point: geo.Point{2, 2}, End: geo.Point{2, 2}},
+			},
+		},
+		{
+			name: "doubles back",
+			line: geo.LineString{{0, 0}, {2, 0}, {1, 0}},
+			result: []SegmentIntersection{
+				{A: 0, B: 1, Point: geo.Point{1, 0}, End: geo.Point{2, 0}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := SelfIntersections(tc.line)
+			if len(result) != len(tc.result) || (len(result) > 0 && !reflect.DeepEqual(result, tc.result)) {
+				t.Errorf("incorrect intersections: %v", result)
+			}
+		})
+	}
+}
+
+func TestIntersectionCollection(t *testing.T) {
+	is := []SegmentIntersection{
+		{A: 0, B: 1, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+		{A: 0, B: 2, Point: geo.Point{1, 1}, End: geo.Point{1, 1}},
+		{A: 1, B: 3, Point: geo.Point{2, 0}, End: geo.Point{3, 0}},
+		{A: 2, B: 4, Point: geo.Point{3, 0}, End: geo.Point{2, 0}},
+	}
+
+	expected := geo.Collection{geo.Point{1, 1}, geo.LineString{{2, 0}, {3, 0}}}
+	if c := IntersectionCollection(is); !reflect.DeepEqual(c, expected) {
+		t.Errorf("incorrect collection: %v", c)
+	}
+}
+
+func TestNodeLines(t *testing.T) {
+	cases := []struct {
+		name   string
+		lines  geo.MultiLineString
+		result geo.MultiLineString
+	}{
+		{
+			name: "cross",
+			lines: geo.MultiLineString{
+				{{0, 0}, {2, 2}},
+				{{0, 2}, {1, 1.5}, {2, 0}},
+			},
+			result: geo.MultiLineString{
+				{{0, 0}, {1.2, 1.2}},
+				{{1.2, 1.2}, {2, 2}},
+				{{0, 2}, {1, 1.5}, {1.2, 1.2}},
+				{{1.2, 1.2}, {2, 0}},
+			},
+		},
+		{
+			name: "self intersection",
+			lines: geo.MultiLineString{
+				{{0, 0}, {2, 2}, {2, 0}, {0, 2}},
+			},
+			result: geo.MultiLineString{
+				{{0, 0}, {1, 1}},
+				{{1, 1}, {2, 2}, {2, 0}, {1, 1}},
+				{{1, 1}, {0, 2}},
+			},
+		},
+		{
+			name: "overlap",
+			lines: geo.MultiLineString{
+				{{0, 0}, {4, 0}},
+				{{1, 0}, {2, 0}, {2, 1}},
+			},
+			result: geo.MultiLineString{
+				{{0, 0}, {1, 0}},
+				{{1, 0}, {2, 0}},
+				{{2, 0}, {4, 0}},
+				{{1, 0}, {2, 0}},
+				{{2, 0}, {2, 1}},
+			},
+		},
+		{
+			name: "end on vertex",
+			lines: geo.MultiLineString{
+				{{0, 0}, {1, 0}, {2, 0}},
+				{{1, 0}, {1, 1}},
+			},
+			result: geo.MultiLineString{
+				{{0, 0}, {1, 0}},
+				{{1, 0}, {2, 0}},
+				{{1, 0}, {1, 1}},
+			},
+		},
+		{
+			name: "closed line",
+			lines: geo.MultiLineString{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+			},
+			result: geo.MultiLineString{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+			},
+		},
+		{
+			name:   "short lines",
+			lines:  geo.MultiLineString{{{0, 0}}, {{1, 1}, {1, 1}}},
+			result: geo.MultiLineString{{{0, 0}}, {{1, 1}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := NodeLines(tc.lines)
+			if !reflect.DeepEqual(result, tc.result) {
+				t.Errorf("incorrect lines: %v", result)
+			}
+		})
+	}
+}
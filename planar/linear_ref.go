@@ -0,0 +1,36 @@
+package planar
+
+import (
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/internal/linear"
+)
+
+var planarSegment = linear.Segment{
+	Distance: Distance,
+	Closest: func(a, b, p geo.Point) float64 {
+		dx, dy := b[0]-a[0], b[1]-a[1]
+		return ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	},
+	Interpolate: func(a, b geo.Point, f float64) geo.Point {
+		return geo.Point{a[0] + f*(b[0]-a[0]), a[1] + f*(b[1]-a[1])}
+	},
+}
+
+// LocatePoint returns the fraction of the length and the distance along the line string
+// of the point on the line closest to p. It panics if the line string is empty.
+func LocatePoint(ls geo.LineString, p geo.Point) (fraction, distance float64) {
+	return linear.Locate(ls, p, planarSegment)
+}
+
+// InterpolatePoint returns the point at the fraction, between 0 and 1,
+// of the length of the line string. It panics if the line string is empty.
+func InterpolatePoint(ls geo.LineString, fraction float64) geo.Point {
+	return linear.Interpolate(ls, fraction, planarSegment)
+}
+
+// Substring returns the part of the line string between the fractions, from 0 to 1,
+// of its length. If from is after to the result is reversed.
+// It panics if the line string is empty.
+func Substring(ls geo.LineString, from, to float64) geo.LineString {
+	return linear.Substring(ls, from, to, planarSegment)
+}
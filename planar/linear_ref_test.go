@@ -0,0 +1,180 @@
+package planar
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+var referenceLine = geo.LineString{{0, 0}, {4, 0}, {4, 3}, {1, 3}}
+
+func TestLocatePoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		line     geo.LineString
+		point    geo.Point
+		fraction float64
+		distance float64
+	}{
+		{
+			name:     "on the line",
+			line:     referenceLine,
+			point:    geo.Point{2, 0},
+			fraction: 0.2,
+			distance: 2,
+		},
+		{
+			name:     "beside the line",
+			line:     referenceLine,
+			point:    geo.Point{5, 1},
+			fraction: 0.5,
+			distance: 5,
+		},
+		{
+			name:     "before the start",
+			line:     referenceLine,
+			point:    geo.Point{-1, -1},
+			fraction: 0,
+			distance: 0,
+		},
+		{
+			name:     "after the end",
+			line:     referenceLine,
+			point:    geo.Point{-2, 3},
+			fraction: 1,
+			distance: 10,
+		},
+		{
+			name:     "single point",
+			line:     geo.LineString{{1, 1}},
+			point:    geo.Point{2, 2},
+			fraction: 0,
+			distance: 0,
+		},
+		{
+			name:     "zero length segment",
+			line:     geo.LineString{{0, 0}, {0, 0}, {2, 0}},
+			point:    geo.Point{1, 1},
+			fraction: 0.5,
+			distance: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, d := LocatePoint(tc.line, tc.point)
+			if math.Abs(f-tc.fraction) > 1e-12 || math.Abs(d-tc.distance) > 1e-12 {
+				t.Errorf("incorrect location: %v %v", f, d)
+			}
+		})
+	}
+}
+
+func TestInterpolatePoint(t *testing.T) {
+	cases := []struct {
+		fraction float64
+		point    geo.Point
+	}{
+		{fraction: -1, point: geo.Point{0, 0}},
+		{fraction: 0, point: geo.Point{0, 0}},
+		{fraction: 0.4, point: geo.Point{4, 0}},
+		{fraction: 0.5, point: geo.Point{4, 1}},
+		{fraction: 0.8, point: geo.Point{3, 3}},
+		{fraction: 1, point: geo.Point{1, 3}},
+		{fraction: 2, point: geo.Point{1, 3}},
+	}
+
+	for _, tc := range cases {
+		if p := InterpolatePoint(referenceLine, tc.fraction); DistanceSquared(p, tc.point) > 1e-20 {
+			t.Errorf("incorrect point for %v: %v != %v", tc.fraction, p, tc.point)
+		}
+	}
+}
+
+func TestInterpolatePoint_locate(t *testing.T) {
+	for _, f := range []float64{0, 0.1, 0.25, 0.7, 1} {
+		p := InterpolatePoint(referenceLine, f)
+		if l, _ := LocatePoint(referenceLine, p); math.Abs(l-f) > 1e-12 {
+			t.Errorf("incorrect fraction: %v != %v", l, f)
+		}
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to float64
+		result   geo.LineString
+	}{
+		{
+			name:   "whole line",
+			from:   0,
+			to:     1,
+			result: referenceLine,
+		},
+		{
+			name:   "within a segment",
+			from:   0.1,
+			to:     0.3,
+			result: geo.LineString{{1, 0}, {3, 0}},
+		},
+		{
+			name:   "across vertices",
+			from:   0.2,
+			to:     0.8,
+			result: geo.LineString{{2, 0}, {4, 0}, {4, 3}, {3, 3}},
+		},
+		{
+			name:   "starts at a vertex",
+			from:   0.4,
+			to:     0.5,
+			result: geo.LineString{{4, 0}, {4, 1}},
+		},
+		{
+			name:   "reversed",
+			from:   0.5,
+			to:     0.2,
+			result: geo.LineString{{4, 1}, {4, 0}, {2, 0}},
+		},
+		{
+			name:   "same fraction",
+			from:   0.5,
+			to:     0.5,
+			result: geo.LineString{{4, 1}, {4, 1}},
+		},
+		{
+			name:   "clamped",
+			from:   -1,
+			to:     0.1,
+			result: geo.LineString{{0, 0}, {1, 0}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if s := Substring(referenceLine, tc.from, tc.to); !reflect.DeepEqual(s, tc.result) {
+				t.Errorf("incorrect substring: %v", s)
+			}
+		})
+	}
+}
+
+func TestSubstring_doesNotModify(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {1, 0}, {2, 0}}
+	Substring(ls, 1, 0)
+	if !reflect.DeepEqual(ls, geo.LineString{{0, 0}, {1, 0}, {2, 0}}) {
+		t.Errorf("line was modified: %v", ls)
+	}
+}
+
+func TestLinearReferencing_empty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+
+	InterpolatePoint(geo.LineString{}, 0.5)
+}
@@ -0,0 +1,205 @@
+package planar
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+)
+
+// MakeValid repairs the areal geometry so it passes Validate.
+// Every ring is closed and split at its self-intersections, the parts of a shell
+// are unioned together, and so are the holes which are then removed from the shell.
+// The result is a multi-polygon with counter-clockwise shells and clockwise holes.
+// Spikes and collapsed rings can not be part of an area, if there are any
+// they are returned with the multi-polygon in a collection as a multi-line string.
+// Points and lines are returned as is, collections are repaired element by element.
+func MakeValid(g geo.Geometry) geo.Geometry {
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case geo.Point, geo.MultiPoint, geo.LineString, geo.MultiLineString:
+		return g
+	case geo.Ring:
+		return makeValid(geo.MultiPolygon{{g}})
+	case geo.Polygon:
+		return makeValid(geo.MultiPolygon{g})
+	case geo.MultiPolygon:
+		return makeValid(g)
+	case geo.Bound:
+		if g.IsEmpty() {
+			return geo.MultiPolygon(nil)
+		}
+
+		return makeValid(geo.MultiPolygon{g.ToPolygon()})
+	case geo.Collection:
+		if g == nil {
+			return g
+		}
+
+		c := make(geo.Collection, 0, len(g))
+		for _, e := range g {
+			c = append(c, MakeValid(e))
+		}
+
+		return c
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func makeValid(mp geo.MultiPolygon) geo.Geometry {
+	if Validate(mp) == nil {
+		// empty polygons have no area
+		var result geo.MultiPolygon
+		for _, p := range mp {
+			if len(p) > 0 {
+				result = append(result, p.Clone())
+			}
+		}
+
+		return result
+	}
+
+	var result geo.MultiPolygon
+	for _, p := range mp {
+		if len(p) == 0 {
+			continue
+		}
+
+		area := validRing(p[0])
+		var holes geo.MultiPolygon
+		for _, h := range p[1:] {
+			holes = Union(holes, validRing(h))
+		}
+
+		if len(holes) > 0 {
+			area = Difference(area, holes)
+		}
+
+		result = Union(result, area)
+	}
+
+	leftovers := uncovered(mp, result)
+	if len(leftovers) == 0 {
+		return result
+	}
+
+	if len(result) == 0 {
+		return geo.Collection{leftovers}
+	}
+
+	return geo.Collection{result, leftovers}
+}
+
+// validRing returns the area enclosed by the ring, parts wound in
+// either direction are included so bow-ties keep both of their lobes.
+func validRing(r geo.Ring) geo.MultiPolygon {
+	return overlay(r, nil, func(wa, wb int) bool {
+		return wa != 0
+	})
+}
+
+// uncovered returns the parts of the rings that collapsed to lines,
+// i.e. are traversed the same number of times in both directions,
+// and are not on the boundary or in the interior of the repaired area.
+func uncovered(mp, area geo.MultiPolygon) geo.MultiLineString {
+	var segs []*segment
+	for _, p := range mp {
+		for _, r := range p {
+			ls := geo.LineString(r)
+			if len(r) > 0 && r[0] != r[len(r)-1] {
+				ls = append(ls[:len(ls):len(ls)], r[0])
+			}
+
+			segs = lineSegments(segs, ls, 0)
+			for i := 0; i < len(ls)-1; i++ {
+				if ls[i] != ls[i+1] {
+					segs = append(segs, &segment{a: ls[i], b: ls[i+1], input: 0, kind: kindArea})
+				}
+			}
+		}
+	}
+
+	segs = areaSegments(segs, area, 1)
+	if len(segs) == 0 {
+		return nil
+	}
+
+	g := newGraph(segs)
+	var edges [][2]int
+	for _, e := range g.edges {
+		if !e.lines[0] || e.counts[0] != 0 {
+			continue
+		}
+
+		if l, r := g.winding(e, 1); l > 0 || r > 0 {
+			continue
+		}
+
+		edges = append(edges, [2]int{e.u, e.v})
+	}
+
+	return mergeEdges(g.vertices, edges)
+}
+
+// mergeEdges joins the undirected edges into line strings
+// that run between vertices not shared by exactly two edges.
+func mergeEdges(vertices []geo.Point, edges [][2]int) geo.MultiLineString {
+	adjacent := make(map[int][]int)
+	for i, e := range edges {
+		adjacent[e[0]] = append(adjacent[e[0]], i)
+		adjacent[e[1]] = append(adjacent[e[1]], i)
+	}
+
+	used := make([]bool, len(edges))
+	walk := func(start, i int) geo.LineString {
+		ls := geo.LineString{vertices[start]}
+		v := start
+		for {
+			used[i] = true
+			if edges[i][0] == v {
+				v = edges[i][1]
+			} else {
+				v = edges[i][0]
+			}
+
+			ls = append(ls, vertices[v])
+			if len(adjacent[v]) != 2 {
+				return ls
+			}
+
+			next := adjacent[v][0]
+			if next == i {
+				next = adjacent[v][1]
+			}
+
+			if used[next] {
+				return ls
+			}
+
+			i = next
+		}
+	}
+
+	var mls geo.MultiLineString
+	for v := range vertices {
+		if len(adjacent[v]) == 2 {
+			continue
+		}
+
+		for _, i := range adjacent[v] {
+			if !used[i] {
+				mls = append(mls, walk(v, i))
+			}
+		}
+	}
+
+	// what is left are closed loops
+	for i, e := range edges {
+		if !used[i] {
+			mls = append(mls, walk(e[0], i))
+		}
+	}
+
+	return mls
+}
@@ -0,0 +1,155 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestMakeValid(t *testing.T) {
+	shell := geo.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	cases := []struct {
+		name      string
+		geom      geo.Geometry
+		area      float64
+		polygons  int
+		leftovers geo.MultiLineString
+	}{
+		{
+			name:     "valid",
+			geom:     geo.Polygon{shell},
+			area:     100,
+			polygons: 1,
+		},
+		{
+			name:     "bow tie",
+			geom:     geo.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			area:     2,
+			polygons: 2,
+		},
+		{
+			name:     "not closed and clockwise",
+			geom:     geo.Polygon{{{0, 0}, {0, 10}, {10, 10}, {10, 0}}},
+			area:     100,
+			polygons: 1,
+		},
+		{
+			name:     "overlapping holes",
+			geom:     geo.Polygon{shell, {{1, 1}, {1, 5}, {5, 5}, {5, 1}, {1, 1}}, {{3, 3}, {3, 7}, {7, 7}, {7, 3}, {3, 3}}},
+			area:     100 - 28,
+			polygons: 1,
+		},
+		{
+			name:     "hole outside shell",
+			geom:     geo.Polygon{shell, {{20, 20}, {20, 21}, {21, 21}, {21, 20}, {20, 20}}},
+			area:     100,
+			polygons: 1,
+		},
+		{
+			name:     "hole splitting polygon",
+			geom:     geo.Polygon{shell, {{-1, 4}, {11, 4}, {11, 6}, {-1, 6}, {-1, 4}}},
+			area:     80,
+			polygons: 2,
+		},
+		{
+			name:      "spike",
+			geom:      geo.Polygon{{{0, 0}, {2, 0}, {3, 0}, {2, 0}, {2, 2}, {0, 0}}},
+			area:      2,
+			polygons:  1,
+			leftovers: geo.MultiLineString{{{2, 0}, {3, 0}}},
+		},
+		{
+			name: "overlapping polygons",
+			geom: geo.MultiPolygon{
+				{shell},
+				{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			},
+			area:     175,
+			polygons: 1,
+		},
+		{
+			name:      "collapsed ring",
+			geom:      geo.Polygon{{{0, 0}, {1, 0}, {2, 0}, {0, 0}}},
+			leftovers: geo.MultiLineString{{{0, 0}, {1, 0}, {2, 0}}},
+		},
+		{
+			name: "empty",
+			geom: geo.Polygon{},
+		},
+		{
+			name:     "empty polygon in multi polygon",
+			geom:     geo.MultiPolygon{{}, {shell}},
+			area:     100,
+			polygons: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := MakeValid(tc.geom)
+
+			var mp geo.MultiPolygon
+			var leftovers geo.MultiLineString
+			switch r := result.(type) {
+			case geo.MultiPolygon:
+				mp = r
+			case geo.Collection:
+				for _, g := range r {
+					switch g := g.(type) {
+					case geo.MultiPolygon:
+						mp = g
+					case geo.MultiLineString:
+						leftovers = g
+					}
+				}
+			default:
+				t.Fatalf("unexpected type: %T", result)
+			}
+
+			if err := Validate(mp); err != nil {
+				t.Errorf("result not valid: %v", err)
+			}
+
+			if len(mp) != tc.polygons {
+				t.Errorf("incorrect number of polygons: %d != %d", len(mp), tc.polygons)
+			}
+
+			if a := Area(mp); math.Abs(a-tc.area) > 1e-9 {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+
+			if !leftovers.Equal(tc.leftovers) {
+				t.Errorf("incorrect leftovers: %v != %v", leftovers, tc.leftovers)
+			}
+		})
+	}
+}
+
+func TestMakeValid_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		// should not panic with unsupported type
+		MakeValid(g)
+	}
+}
+
+func TestMakeValid_random(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 50; i++ {
+		ring := make(geo.Ring, 0, 12)
+		for j := 0; j < 11; j++ {
+			ring = append(ring, geo.Point{float64(r.Intn(8)), float64(r.Intn(8))})
+		}
+
+		result := MakeValid(geo.Polygon{append(ring, ring[0])})
+		if c, ok := result.(geo.Collection); ok {
+			// the multi polygon comes first if there is one
+			result = c[0]
+		}
+
+		if err := Validate(result); err != nil {
+			t.Errorf("%d: result not valid: %v: %v", i, err, ring)
+		}
+	}
+}
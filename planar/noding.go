@@ -0,0 +1,479 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pchchv/geo"
+)
+
+// snapFactor scales the extent of the input to get the
+// distance within which two vertices are considered the same.
+const snapFactor = 1e-10
+
+// segmentKind is the kind of geometry a segment is part of.
+type segmentKind int
+
+const (
+	kindArea segmentKind = iota
+	kindLine
+	kindPoint
+)
+
+// segment is an input segment tagged with the input it came from.
+// Points are zero length segments so they get noded into the graph.
+// Start and end mark the segments at the ends of a line.
+type segment struct {
+	a, b       geo.Point
+	input      int
+	kind       segmentKind
+	start, end bool
+	splits     []split
+}
+
+// split is a point along a segment where it must be noded.
+type split struct {
+	t float64
+	p geo.Point
+}
+
+// edge is a noded, deduplicated edge of the planar graph.
+// Counts hold, per input, the number of times the edge was
+// seen as part of an area going from u to v minus the number of
+// times from v to u. Lines mark the edges that are part of a line.
+type edge struct {
+	u, v   int
+	counts [2]int
+	lines  [2]bool
+}
+
+// graph is the noded planar arrangement of one or two inputs.
+type graph struct {
+	eps      float64
+	vertices []geo.Point
+	edges    []*edge
+	cells    map[[2]int64][]int
+	index    map[[2]int]*edge
+	bands    [][]*edge
+	minY     float64
+	bandSize float64
+	// lineEnds counts the line end points at a vertex,
+	// points marks the vertices that are input points.
+	lineEnds map[int][2]int
+	points   map[int][2]bool
+}
+
+// newGraph nodes all the segments at their mutual
+// intersections and merges duplicated edges.
+func newGraph(segs []*segment) *graph {
+	g := &graph{
+		cells:    make(map[[2]int64][]int),
+		index:    make(map[[2]int]*edge),
+		lineEnds: make(map[int][2]int),
+		points:   make(map[int][2]bool),
+	}
+
+	var b geo.Bound
+	for i, s := range segs {
+		if i == 0 {
+			b = geo.Bound{Min: s.a, Max: s.a}
+		}
+
+		b = b.Extend(s.a).Extend(s.b)
+	}
+
+	extent := math.Max(b.Max[0]-b.Min[0], b.Max[1]-b.Min[1])
+	extent = math.Max(extent, math.Max(
+		math.Max(math.Abs(b.Min[0]), math.Abs(b.Max[0])),
+		math.Max(math.Abs(b.Min[1]), math.Abs(b.Max[1])),
+	)*1e-3)
+	g.eps = extent * snapFactor
+
+	nodeSegments(segs, g.eps)
+	for _, s := range segs {
+		sort.Slice(s.splits, func(i, j int) bool {
+			return s.splits[i].t < s.splits[j].t
+		})
+
+		prev := g.vertex(s.a)
+		if s.kind == kindPoint {
+			ps := g.points[prev]
+			ps[s.input] = true
+			g.points[prev] = ps
+			continue
+		}
+
+		if s.start {
+			g.lineEnd(prev, s.input)
+		}
+
+		for _, sp := range s.splits {
+			v := g.vertex(sp.p)
+			g.addEdge(prev, v, s)
+			prev = v
+		}
+
+		v := g.vertex(s.b)
+		g.addEdge(prev, v, s)
+		if s.end {
+			g.lineEnd(v, s.input)
+		}
+	}
+
+	edges := g.edges[:0]
+	for _, e := range g.edges {
+		if e.counts[0] != 0 || e.counts[1] != 0 || e.lines[0] || e.lines[1] {
+			edges = append(edges, e)
+		}
+	}
+
+	g.edges = edges
+	g.buildBands()
+	return g
+}
+
+// buildBands buckets the edges into horizontal bands
+// so the winding number computation only looks at
+// the edges that can cross the ray.
+func (g *graph) buildBands() {
+	if len(g.edges) == 0 {
+		return
+	}
+
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, e := range g.edges {
+		minY = math.Min(minY, math.Min(g.vertices[e.u][1], g.vertices[e.v][1]))
+		maxY = math.Max(maxY, math.Max(g.vertices[e.u][1], g.vertices[e.v][1]))
+	}
+
+	n := int(math.Sqrt(float64(len(g.edges)))) + 1
+	g.minY = minY
+	g.bandSize = (maxY - minY) / float64(n)
+	if g.bandSize == 0 {
+		g.bands = [][]*edge{g.edges}
+		return
+	}
+
+	g.bands = make([][]*edge, n)
+	for _, e := range g.edges {
+		lo, hi := g.vertices[e.u][1], g.vertices[e.v][1]
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+
+		for i := g.band(lo); i <= g.band(hi); i++ {
+			g.bands[i] = append(g.bands[i], e)
+		}
+	}
+}
+
+func (g *graph) band(y float64) int {
+	if g.bandSize == 0 {
+		return 0
+	}
+
+	i := int((y - g.minY) / g.bandSize)
+	if i < 0 {
+		return 0
+	} else if i >= len(g.bands) {
+		return len(g.bands) - 1
+	}
+
+	return i
+}
+
+// vertex returns the id of the vertex at the point,
+// snapping to an existing vertex if one is close enough.
+func (g *graph) vertex(p geo.Point) int {
+	if g.eps == 0 {
+		key := [2]int64{int64(math.Float64bits(p[0])), int64(math.Float64bits(p[1]))}
+		if ids, ok := g.cells[key]; ok {
+			return ids[0]
+		}
+
+		g.vertices = append(g.vertices, p)
+		g.cells[key] = []int{len(g.vertices) - 1}
+		return len(g.vertices) - 1
+	}
+
+	cx := int64(math.Floor(p[0] / g.eps))
+	cy := int64(math.Floor(p[1] / g.eps))
+	best, bestDist := -1, math.Inf(1)
+	for x := cx - 1; x <= cx+1; x++ {
+		for y := cy - 1; y <= cy+1; y++ {
+			for _, id := range g.cells[[2]int64{x, y}] {
+				if d := DistanceSquared(g.vertices[id], p); d <= g.eps*g.eps && d < bestDist {
+					best, bestDist = id, d
+				}
+			}
+		}
+	}
+
+	if best >= 0 {
+		return best
+	}
+
+	g.vertices = append(g.vertices, p)
+	id := len(g.vertices) - 1
+	g.cells[[2]int64{cx, cy}] = append(g.cells[[2]int64{cx, cy}], id)
+	return id
+}
+
+func (g *graph) lineEnd(v, input int) {
+	ends := g.lineEnds[v]
+	ends[input]++
+	g.lineEnds[v] = ends
+}
+
+func (g *graph) addEdge(u, v int, s *segment) {
+	if u == v {
+		return
+	}
+
+	dir := 1
+	key := [2]int{u, v}
+	if u > v {
+		dir = -1
+		key = [2]int{v, u}
+	}
+
+	e := g.index[key]
+	if e == nil {
+		e = &edge{u: key[0], v: key[1]}
+		g.index[key] = e
+		g.edges = append(g.edges, e)
+	}
+
+	if s.kind == kindLine {
+		e.lines[s.input] = true
+	} else {
+		e.counts[s.input] += dir
+	}
+}
+
+// winding returns the winding number of the input around
+// the midpoint of the edge, on its left and right side.
+func (g *graph) winding(e *edge, input int) (left, right int) {
+	u, v := g.vertices[e.u], g.vertices[e.v]
+	p := geo.Point{(u[0] + v[0]) / 2, (u[1] + v[1]) / 2}
+
+	// winding number of the midpoint ignoring the edge itself,
+	// the half-open rule makes this the value just north of
+	// horizontal edges and just east of all the others.
+	w := g.windingAt(p, input, e)
+	c := e.counts[input]
+	dx, dy := v[0]-u[0], v[1]-u[1]
+	if dy > 0 || (dy == 0 && dx < 0) {
+		// east or north is on the right
+		return w + c, w
+	}
+
+	return w, w - c
+}
+
+// windingAt returns the winding number of the area
+// edges of the input around the point, skipping the given edge.
+func (g *graph) windingAt(p geo.Point, input int, skip *edge) (w int) {
+	if len(g.bands) == 0 {
+		return 0
+	}
+
+	for _, f := range g.bands[g.band(p[1])] {
+		c := f.counts[input]
+		if f == skip || c == 0 {
+			continue
+		}
+
+		a, b := g.vertices[f.u], g.vertices[f.v]
+		if a[1] <= p[1] {
+			if b[1] > p[1] && orient(a, b, p) > 0 {
+				w += c
+			}
+		} else if b[1] <= p[1] && orient(a, b, p) < 0 {
+			w -= c
+		}
+	}
+
+	return w
+}
+
+// nodeSegments records, for every segment,
+// the points where other segments touch or cross it.
+func nodeSegments(segs []*segment, eps float64) {
+	bounds := make([]geo.Bound, len(segs))
+	for i, s := range segs {
+		bounds[i] = segmentBound(s.a, s.b).Pad(eps)
+	}
+
+	sweepPairs(bounds, func(i, j int) {
+		intersectSegments(segs[i], segs[j], eps)
+	})
+}
+
+func intersectSegments(s1, s2 *segment, eps float64) {
+	touched := false
+	for _, p := range [2]geo.Point{s2.a, s2.b} {
+		if t, ok := onSegment(s1.a, s1.b, p, eps); ok {
+			s1.splits = append(s1.splits, split{t: t, p: p})
+			touched = true
+		}
+	}
+
+	for _, p := range [2]geo.Point{s1.a, s1.b} {
+		if t, ok := onSegment(s2.a, s2.b, p, eps); ok {
+			s2.splits = append(s2.splits, split{t: t, p: p})
+			touched = true
+		}
+	}
+
+	if touched {
+		// touching segments can only cross at the touch point
+		// unless they are collinear which is already handled
+		return
+	}
+
+	for _, p := range [2]geo.Point{s1.a, s1.b} {
+		if DistanceSquared(p, s2.a) <= eps*eps || DistanceSquared(p, s2.b) <= eps*eps {
+			// segments sharing an end point can not cross, nearly
+			// parallel ones could otherwise find a false intersection
+			return
+		}
+	}
+
+	d1 := geo.Point{s1.b[0] - s1.a[0], s1.b[1] - s1.a[1]}
+	d2 := geo.Point{s2.b[0] - s2.a[0], s2.b[1] - s2.a[1]}
+	den := d1[0]*d2[1] - d1[1]*d2[0]
+	if den == 0 {
+		return
+	}
+
+	w := geo.Point{s2.a[0] - s1.a[0], s2.a[1] - s1.a[1]}
+	t := (w[0]*d2[1] - w[1]*d2[0]) / den
+	u := (w[0]*d1[1] - w[1]*d1[0]) / den
+	if t <= 0 || t >= 1 || u <= 0 || u >= 1 {
+		return
+	}
+
+	p := geo.Point{s1.a[0] + t*d1[0], s1.a[1] + t*d1[1]}
+	s1.splits = append(s1.splits, split{t: t, p: p})
+	s2.splits = append(s2.splits, split{t: u, p: p})
+}
+
+// onSegment returns the parameter of the point along the
+// segment [a, b] if it lies strictly inside the segment.
+func onSegment(a, b, p geo.Point, eps float64) (float64, bool) {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return 0, false
+	}
+
+	if DistanceSquared(a, p) <= eps*eps || DistanceSquared(b, p) <= eps*eps {
+		return 0, false
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / l2
+	if t <= 0 || t >= 1 {
+		return 0, false
+	}
+
+	cross := dx*(p[1]-a[1]) - dy*(p[0]-a[0])
+	if cross*cross > eps*eps*l2 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// orient returns a positive value if c is to the left of the
+// line through a and b, negative if to the right and 0 if collinear.
+func orient(a, b, c geo.Point) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// Kinds of intersection between two segments.
+const (
+	noIntersection = iota
+	pointIntersection
+	collinearIntersection
+)
+
+// segmentIntersection finds the intersection of the segments [a1, a2] and [b1, b2].
+// For collinear segments that overlap, the returned point
+// is the start of the overlap.
+func segmentIntersection(a1, a2, b1, b2 geo.Point) (int, geo.Point) {
+	o1 := orient(a1, a2, b1)
+	o2 := orient(a1, a2, b2)
+	o3 := orient(b1, b2, a1)
+	o4 := orient(b1, b2, a2)
+
+	if o1 == 0 && o2 == 0 && o3 == 0 && o4 == 0 {
+		kind, start, _ := collinearOverlap(a1, a2, b1, b2)
+		return kind, start
+	}
+
+	if ((o1 > 0 && o2 < 0) || (o1 < 0 && o2 > 0)) &&
+		((o3 > 0 && o4 < 0) || (o3 < 0 && o4 > 0)) {
+		d1 := geo.Point{a2[0] - a1[0], a2[1] - a1[1]}
+		t := o3 / (o3 - o4)
+		return pointIntersection, geo.Point{a1[0] + t*d1[0], a1[1] + t*d1[1]}
+	}
+
+	switch {
+	case o1 == 0 && onSegmentExact(a1, a2, b1):
+		return pointIntersection, b1
+	case o2 == 0 && onSegmentExact(a1, a2, b2):
+		return pointIntersection, b2
+	case o3 == 0 && onSegmentExact(b1, b2, a1):
+		return pointIntersection, a1
+	case o4 == 0 && onSegmentExact(b1, b2, a2):
+		return pointIntersection, a2
+	}
+
+	return noIntersection, geo.Point{}
+}
+
+// collinearOverlap returns the start and end of the overlap of collinear segments.
+func collinearOverlap(a1, a2, b1, b2 geo.Point) (int, geo.Point, geo.Point) {
+	// project on the axis with the largest extent
+	axis := 0
+	if math.Abs(a2[1]-a1[1])+math.Abs(b2[1]-b1[1]) > math.Abs(a2[0]-a1[0])+math.Abs(b2[0]-b1[0]) {
+		axis = 1
+	}
+
+	if a1[axis] > a2[axis] {
+		a1, a2 = a2, a1
+	}
+
+	if b1[axis] > b2[axis] {
+		b1, b2 = b2, b1
+	}
+
+	start, end := a1, a2
+	if b1[axis] > start[axis] {
+		start = b1
+	}
+
+	if b2[axis] < end[axis] {
+		end = b2
+	}
+
+	switch {
+	case start[axis] > end[axis]:
+		return noIntersection, geo.Point{}, geo.Point{}
+	case start[axis] == end[axis]:
+		return pointIntersection, start, start
+	}
+
+	return collinearIntersection, start, end
+}
+
+// onSegmentExact returns true if the point is exactly on the segment [a, b].
+func onSegmentExact(a, b, p geo.Point) bool {
+	if orient(a, b, p) != 0 {
+		return false
+	}
+
+	return math.Min(a[0], b[0]) <= p[0] && p[0] <= math.Max(a[0], b[0]) &&
+		math.Min(a[1], b[1]) <= p[1] && p[1] <= math.Max(a[1], b[1])
+}
@@ -0,0 +1,336 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pchchv/geo"
+)
+
+// Union returns the area covered by either of the geometries.
+// Only the areal parts of the geometries, i.e. rings, polygons,
+// multi-polygons and bounds, are considered.
+func Union(a, b geo.Geometry) geo.MultiPolygon {
+	return overlay(a, b, func(wa, wb int) bool {
+		return wa > 0 || wb > 0
+	})
+}
+
+// Intersection returns the area covered by both of the geometries.
+// Only the areal parts of the geometries, i.e. rings, polygons,
+// multi-polygons and bounds, are considered.
+func Intersection(a, b geo.Geometry) geo.MultiPolygon {
+	return overlay(a, b, func(wa, wb int) bool {
+		return wa > 0 && wb > 0
+	})
+}
+
+// Difference returns the area covered by the first geometry but not the second.
+// Only the areal parts of the geometries, i.e. rings, polygons,
+// multi-polygons and bounds, are considered.
+func Difference(a, b geo.Geometry) geo.MultiPolygon {
+	return overlay(a, b, func(wa, wb int) bool {
+		return wa > 0 && wb <= 0
+	})
+}
+
+// SymDifference returns the area covered by exactly one of the geometries.
+// Only the areal parts of the geometries, i.e. rings, polygons,
+// multi-polygons and bounds, are considered.
+func SymDifference(a, b geo.Geometry) geo.MultiPolygon {
+	return overlay(a, b, func(wa, wb int) bool {
+		return (wa > 0) != (wb > 0)
+	})
+}
+
+// overlay nodes the boundaries of the two geometries together
+// and keeps the edges that separate an area in the result
+// from one that is not. These edges are then assembled into
+// the rings of the output polygons.
+func overlay(a, b geo.Geometry, in func(wa, wb int) bool) geo.MultiPolygon {
+	segs := areaSegments(nil, a, 0)
+	segs = areaSegments(segs, b, 1)
+	if len(segs) == 0 {
+		return nil
+	}
+
+	g := newGraph(segs)
+	var result [][2]int
+	for _, e := range g.edges {
+		la, ra := g.winding(e, 0)
+		lb, rb := g.winding(e, 1)
+		left, right := in(la, lb), in(ra, rb)
+		if left && !right {
+			result = append(result, [2]int{e.u, e.v})
+		} else if right && !left {
+			result = append(result, [2]int{e.v, e.u})
+		}
+	}
+
+	return buildPolygons(g.vertices, result)
+}
+
+// areaSegments appends the segments of the areal parts of the geometry.
+// Rings are oriented so the interior of the geometry is on the left.
+func areaSegments(segs []*segment, g geo.Geometry, input int) []*segment {
+	switch g := g.(type) {
+	case geo.Ring:
+		segs = ringSegments(segs, g, geo.CCW, input)
+	case geo.Polygon:
+		segs = polygonSegments(segs, g, input)
+	case geo.MultiPolygon:
+		for _, p := range g {
+			segs = polygonSegments(segs, p, input)
+		}
+	case geo.Collection:
+		for _, c := range g {
+			segs = areaSegments(segs, c, input)
+		}
+	case geo.Bound:
+		if !g.IsEmpty() {
+			segs = ringSegments(segs, g.ToRing(), geo.CCW, input)
+		}
+	}
+
+	return segs
+}
+
+func polygonSegments(segs []*segment, p geo.Polygon, input int) []*segment {
+	for i, r := range p {
+		if i == 0 {
+			segs = ringSegments(segs, r, geo.CCW, input)
+		} else {
+			segs = ringSegments(segs, r, geo.CW, input)
+		}
+	}
+
+	return segs
+}
+
+// ringSegments appends the segments of the ring, implicitly closing it,
+// in the order that matches the given orientation.
+func ringSegments(segs []*segment, r geo.Ring, o geo.Orientation, input int) []*segment {
+	if len(r) < 3 {
+		return segs
+	}
+
+	reverse := r.Orientation() != o
+	n := len(r)
+	for i := 0; i < n; i++ {
+		a, b := r[i], r[(i+1)%n]
+		if a == b {
+			continue
+		}
+
+		if reverse {
+			a, b = b, a
+		}
+
+		segs = append(segs, &segment{a: a, b: b, input: input})
+	}
+
+	return segs
+}
+
+// buildPolygons assembles the directed edges, with the interior on their left,
+// into rings and groups them into polygons.
+// Shells are counter-clockwise and holes clockwise.
+func buildPolygons(vertices []geo.Point, edges [][2]int) geo.MultiPolygon {
+	outgoing := make(map[int][]int)
+	for i, e := range edges {
+		outgoing[e[0]] = append(outgoing[e[0]], i)
+	}
+
+	// the next edge is the first outgoing edge clockwise
+	// from the edge we came in on, this keeps the rings
+	// separate where they touch at a vertex.
+	next := func(i int) int {
+		e := edges[i]
+		v := vertices[e[1]]
+		u := vertices[e[0]]
+		back := math.Atan2(u[1]-v[1], u[0]-v[0])
+
+		best, bestAngle := -1, math.Inf(1)
+		for _, j := range outgoing[e[1]] {
+			w := vertices[edges[j][1]]
+			angle := back - math.Atan2(w[1]-v[1], w[0]-v[0])
+			for angle <= 0 {
+				angle += 2 * math.Pi
+			}
+
+			if angle < bestAngle {
+				best, bestAngle = j, angle
+			}
+		}
+
+		return best
+	}
+
+	var shells, holes []geo.Ring
+	used := make([]bool, len(edges))
+	for i := range edges {
+		if used[i] {
+			continue
+		}
+
+		var cycle []int
+		for j := i; j >= 0 && !used[j]; j = next(j) {
+			used[j] = true
+			cycle = append(cycle, edges[j][0])
+		}
+
+		for _, r := range splitCycle(vertices, cycle) {
+			r = cleanRing(r)
+			if r == nil {
+				continue
+			}
+
+			_, area := ringCentroidArea(r)
+			if area > 0 {
+				shells = append(shells, r)
+			} else if area < 0 {
+				holes = append(holes, r)
+			}
+		}
+	}
+
+	return assemblePolygons(shells, holes)
+}
+
+// splitCycle splits the cycle of vertices into simple rings
+// where it passes through the same vertex more than once,
+// e.g. two holes touching at a point.
+func splitCycle(vertices []geo.Point, cycle []int) []geo.Ring {
+	var (
+		rings []geo.Ring
+		stack []int
+	)
+
+	position := make(map[int]int)
+	for _, v := range cycle {
+		if k, ok := position[v]; ok {
+			r := make(geo.Ring, 0, len(stack)-k)
+			for _, u := range stack[k:] {
+				r = append(r, vertices[u])
+				delete(position, u)
+			}
+
+			rings = append(rings, r)
+			stack = stack[:k]
+		}
+
+		position[v] = len(stack)
+		stack = append(stack, v)
+	}
+
+	r := make(geo.Ring, 0, len(stack))
+	for _, u := range stack {
+		r = append(r, vertices[u])
+	}
+
+	return append(rings, r)
+}
+
+// assemblePolygons puts every hole into the smallest shell that contains it.
+func assemblePolygons(shells, holes []geo.Ring) geo.MultiPolygon {
+	if len(shells) == 0 {
+		return nil
+	}
+
+	sortRings(shells)
+	sortRings(holes)
+
+	areas := make([]float64, len(shells))
+	bounds := make([]geo.Bound, len(shells))
+	for i, s := range shells {
+		_, areas[i] = ringCentroidArea(s)
+		bounds[i] = s.Bound()
+	}
+
+	mp := make(geo.MultiPolygon, len(shells))
+	for i, s := range shells {
+		mp[i] = geo.Polygon{s}
+	}
+
+	for _, h := range holes {
+		// the midpoint of an edge is never on the boundary of another ring
+		p := geo.Point{(h[0][0] + h[1][0]) / 2, (h[0][1] + h[1][1]) / 2}
+		best := -1
+		for i, s := range shells {
+			if !bounds[i].Contains(p) || !RingContains(s, p) {
+				continue
+			}
+
+			if best == -1 || areas[i] < areas[best] {
+				best = i
+			}
+		}
+
+		if best >= 0 {
+			mp[best] = append(mp[best], h)
+		}
+	}
+
+	return mp
+}
+
+// cleanRing removes consecutive duplicates and vertices in the middle of
+// straight lines, rotates the ring to start at its lowest point and closes it.
+func cleanRing(r geo.Ring) geo.Ring {
+	if len(r) > 1 && r[0] == r[len(r)-1] {
+		r = r[:len(r)-1]
+	}
+
+	for changed := true; changed && len(r) >= 3; {
+		changed = false
+		out := r[:0:0]
+		for i, p := range r {
+			prev := r[(i+len(r)-1)%len(r)]
+			next := r[(i+1)%len(r)]
+			if p == prev {
+				changed = true
+				continue
+			}
+
+			if orient(prev, p, next) == 0 &&
+				(p[0]-prev[0])*(next[0]-p[0])+(p[1]-prev[1])*(next[1]-p[1]) > 0 {
+				changed = true
+				continue
+			}
+
+			out = append(out, p)
+		}
+
+		r = out
+	}
+
+	if len(r) < 3 {
+		return nil
+	}
+
+	start := 0
+	for i, p := range r {
+		if lessPoint(p, r[start]) {
+			start = i
+		}
+	}
+
+	out := make(geo.Ring, 0, len(r)+1)
+	out = append(out, r[start:]...)
+	out = append(out, r[:start]...)
+	return append(out, out[0])
+}
+
+func sortRings(rs []geo.Ring) {
+	sort.Slice(rs, func(i, j int) bool {
+		return lessPoint(rs[i][0], rs[j][0])
+	})
+}
+
+func lessPoint(a, b geo.Point) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+
+	return a[1] < b[1]
+}
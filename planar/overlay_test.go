@@ -0,0 +1,222 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestOverlay(t *testing.T) {
+	square := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	shifted := geo.Polygon{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}}
+	adjacent := geo.Polygon{{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}}}
+	donut := geo.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+	}
+
+	cases := []struct {
+		name   string
+		result geo.MultiPolygon
+		area   float64
+		count  int
+	}{
+		{
+			name:   "union of overlapping",
+			result: Union(square, shifted),
+			area:   7,
+			count:  1,
+		},
+		{
+			name:   "intersection of overlapping",
+			result: Intersection(square, shifted),
+			area:   1,
+			count:  1,
+		},
+		{
+			name:   "difference of overlapping",
+			result: Difference(square, shifted),
+			area:   3,
+			count:  1,
+		},
+		{
+			name:   "sym difference of overlapping",
+			result: SymDifference(square, shifted),
+			area:   6,
+			count:  2,
+		},
+		{
+			name:   "union with shared edge",
+			result: Union(square, adjacent),
+			area:   8,
+			count:  1,
+		},
+		{
+			name:   "intersection with shared edge",
+			result: Intersection(square, adjacent),
+			area:   0,
+			count:  0,
+		},
+		{
+			name:   "union with itself",
+			result: Union(square, square),
+			area:   4,
+			count:  1,
+		},
+		{
+			name:   "difference with itself",
+			result: Difference(square, square),
+			area:   0,
+			count:  0,
+		},
+		{
+			name:   "cut through the hole",
+			result: Difference(donut, geo.Bound{Min: geo.Point{-1, 4}, Max: geo.Point{11, 6}}),
+			area:   64 - 8,
+			count:  2,
+		},
+		{
+			name:   "island in the hole",
+			result: Union(donut, geo.Bound{Min: geo.Point{4, 4}, Max: geo.Point{6, 6}}),
+			area:   64 + 4,
+			count:  2,
+		},
+		{
+			name:   "intersect hole",
+			result: Intersection(donut, geo.Bound{Min: geo.Point{1, 1}, Max: geo.Point{9, 9}}),
+			area:   64 - 36,
+			count:  1,
+		},
+		{
+			name:   "touching corners",
+			result: Union(geo.Bound{Max: geo.Point{1, 1}}, geo.Bound{Min: geo.Point{1, 1}, Max: geo.Point{2, 2}}),
+			area:   2,
+			count:  2,
+		},
+		{
+			name:   "multi polygon with overlapping parts",
+			result: Union(geo.MultiPolygon{square, shifted}, nil),
+			area:   7,
+			count:  1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.result) != tc.count {
+				t.Errorf("wrong number of polygons: %d != %d", len(tc.result), tc.count)
+			}
+
+			if a := Area(tc.result); math.Abs(a-tc.area) > 1e-9 {
+				t.Errorf("wrong area: %v != %v", a, tc.area)
+			}
+
+			checkOverlayResult(t, tc.result)
+		})
+	}
+}
+
+func TestOverlay_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		a := randomStar(r, geo.Point{r.Float64(), r.Float64()}, 20)
+		b := randomStar(r, geo.Point{r.Float64(), r.Float64()}, 20)
+
+		areaA, areaB := Area(a), Area(b)
+		inter := Area(Intersection(a, b))
+		union := Area(Union(a, b))
+		diff := Area(Difference(a, b))
+		sym := Area(SymDifference(a, b))
+
+		if math.Abs(union-(areaA+areaB-inter)) > 1e-9 {
+			t.Errorf("%d: union area incorrect: %v != %v", i, union, areaA+areaB-inter)
+		}
+
+		if math.Abs(diff-(areaA-inter)) > 1e-9 {
+			t.Errorf("%d: difference area incorrect: %v != %v", i, diff, areaA-inter)
+		}
+
+		if math.Abs(sym-(union-inter)) > 1e-9 {
+			t.Errorf("%d: sym difference area incorrect: %v != %v", i, sym, union-inter)
+		}
+
+		checkOverlayResult(t, Union(a, b))
+		checkOverlayResult(t, Intersection(a, b))
+		checkOverlayResult(t, Difference(a, b))
+		checkOverlayResult(t, SymDifference(a, b))
+	}
+}
+
+func TestOverlay_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		// should not panic with unsupported type
+		Union(g, g)
+		Intersection(g, g)
+		Difference(g, g)
+		SymDifference(g, g)
+	}
+}
+
+func checkOverlayResult(t testing.TB, mp geo.MultiPolygon) {
+	t.Helper()
+	if err := Validate(mp); err != nil {
+		t.Errorf("result should be valid: %v", err)
+	}
+
+	for _, p := range mp {
+		for i, r := range p {
+			if !r.Closed() {
+				t.Errorf("ring not closed: %v", r)
+			}
+
+			o := r.Orientation()
+			if i == 0 && o != geo.CCW {
+				t.Errorf("shell should be counter clockwise: %v", r)
+			} else if i > 0 && o != geo.CW {
+				t.Errorf("hole should be clockwise: %v", r)
+			}
+		}
+	}
+}
+
+func randomStar(r *rand.Rand, c geo.Point, n int) geo.Polygon {
+	ring := make(geo.Ring, 0, n+1)
+	for i := 0; i < n; i++ {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		d := 0.2 + 0.8*r.Float64()
+		ring = append(ring, geo.Point{c[0] + d*math.Cos(a), c[1] + d*math.Sin(a)})
+	}
+
+	return geo.Polygon{append(ring, ring[0])}
+}
+
+func TestOverlay_sharedEdges(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 100; i++ {
+		a := randomGridBound(r)
+		b := randomGridBound(r)
+
+		inter := Area(Intersection(a, b))
+		union := Area(Union(a, b))
+		if math.Abs(union-(Area(a)+Area(b)-inter)) > 1e-9 {
+			t.Errorf("%d: union area incorrect: %v != %v", i, union, Area(a)+Area(b)-inter)
+		}
+
+		if diff := Area(Difference(a, b)); math.Abs(diff-(Area(a)-inter)) > 1e-9 {
+			t.Errorf("%d: difference area incorrect: %v != %v", i, diff, Area(a)-inter)
+		}
+
+		checkOverlayResult(t, Union(a, b))
+		checkOverlayResult(t, SymDifference(a, b))
+	}
+}
+
+func randomGridBound(r *rand.Rand) geo.Bound {
+	x, y := float64(r.Intn(4)), float64(r.Intn(4))
+	return geo.Bound{
+		Min: geo.Point{x, y},
+		Max: geo.Point{x + float64(1+r.Intn(3)), y + float64(1+r.Intn(3))},
+	}
+}
@@ -0,0 +1,193 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pchchv/geo"
+)
+
+// PointOnSurface returns a point that is on the geometry, in the interior of its areas
+// if it has any. It is cheaper than PoleOfInaccessibility, for areas the point is
+// in the middle of the widest section of a horizontal line through the middle of a polygon.
+// For lines it is the interior vertex closest to the centroid and for points
+// the point closest to the centroid. Returns the zero point for empty geometries.
+func PointOnSurface(g geo.Geometry) geo.Point {
+	s := &surface{}
+	s.add(g)
+
+	if p, ok := s.areaPoint(); ok {
+		return p
+	}
+
+	// areas without width are treated as lines
+	for _, p := range s.polygons {
+		for _, r := range p {
+			s.lines = append(s.lines, geo.LineString(r))
+		}
+	}
+
+	if p, ok := s.linePoint(); ok {
+		return p
+	}
+
+	return s.point()
+}
+
+// surfacePolygons returns the polygons of the areas of the geometry.
+func surfacePolygons(g geo.Geometry) []geo.Polygon {
+	s := &surface{}
+	s.add(g)
+	return s.polygons
+}
+
+type surface struct {
+	polygons []geo.Polygon
+	lines    []geo.LineString
+	points   []geo.Point
+}
+
+func (s *surface) add(g geo.Geometry) {
+	switch g := g.(type) {
+	case nil:
+	case geo.Point:
+		s.points = append(s.points, g)
+	case geo.MultiPoint:
+		s.points = append(s.points, g...)
+	case geo.LineString:
+		s.lines = append(s.lines, g)
+	case geo.MultiLineString:
+		s.lines = append(s.lines, g...)
+	case geo.Ring:
+		s.polygons = append(s.polygons, geo.Polygon{g})
+	case geo.Polygon:
+		s.polygons = append(s.polygons, g)
+	case geo.MultiPolygon:
+		s.polygons = append(s.polygons, g...)
+	case geo.Collection:
+		for _, c := range g {
+			s.add(c)
+		}
+	case geo.Bound:
+		if !g.IsEmpty() {
+			s.polygons = append(s.polygons, g.ToPolygon())
+		}
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+// areaPoint returns the middle of the widest interior section
+// of the scan lines through the polygons.
+func (s *surface) areaPoint() (geo.Point, bool) {
+	var (
+		best  geo.Point
+		width float64
+	)
+
+	for _, p := range s.polygons {
+		if len(p) == 0 || len(p[0]) == 0 {
+			continue
+		}
+
+		y := scanLineY(p)
+		var xs []float64
+		for _, r := range p {
+			for i := 1; i < len(r); i++ {
+				a, b := r[i-1], r[i]
+				if (a[1] > y) != (b[1] > y) {
+					xs = append(xs, a[0]+(y-a[1])*(b[0]-a[0])/(b[1]-a[1]))
+				}
+			}
+		}
+
+		sort.Float64s(xs)
+		for i := 1; i < len(xs); i += 2 {
+			if w := xs[i] - xs[i-1]; w > width {
+				width = w
+				best = geo.Point{(xs[i-1] + xs[i]) / 2, y}
+			}
+		}
+	}
+
+	return best, width > 0
+}
+
+// scanLineY returns a y between the middle of the polygon and the closest
+// vertices above and below it, so the scan line does not touch a vertex.
+func scanLineY(p geo.Polygon) float64 {
+	b := p[0].Bound()
+	center := (b.Min[1] + b.Max[1]) / 2
+	lo, hi := b.Min[1], b.Max[1]
+	for _, r := range p {
+		for _, v := range r {
+			if v[1] <= center && v[1] > lo {
+				lo = v[1]
+			} else if v[1] > center && v[1] < hi {
+				hi = v[1]
+			}
+		}
+	}
+
+	return (lo + hi) / 2
+}
+
+// linePoint returns the interior vertex closest to the centroid of the lines,
+// or the closest end point if there are no interior vertices.
+func (s *surface) linePoint() (geo.Point, bool) {
+	if len(s.lines) == 0 {
+		return geo.Point{}, false
+	}
+
+	c, _ := CentroidArea(geo.MultiLineString(s.lines))
+
+	var (
+		best  geo.Point
+		found bool
+	)
+
+	minDist := math.Inf(1)
+	for _, ls := range s.lines {
+		for i := 1; i < len(ls)-1; i++ {
+			if d := DistanceSquared(c, ls[i]); d < minDist {
+				best, minDist, found = ls[i], d, true
+			}
+		}
+	}
+
+	if found {
+		return best, true
+	}
+
+	for _, ls := range s.lines {
+		if len(ls) == 0 {
+			continue
+		}
+
+		for _, p := range [2]geo.Point{ls[0], ls[len(ls)-1]} {
+			if d := DistanceSquared(c, p); d < minDist {
+				best, minDist, found = p, d, true
+			}
+		}
+	}
+
+	return best, found
+}
+
+// point returns the point closest to the centroid of the points.
+func (s *surface) point() geo.Point {
+	if len(s.points) == 0 {
+		return geo.Point{}
+	}
+
+	c, _ := CentroidArea(geo.MultiPoint(s.points))
+	best := s.points[0]
+	for _, p := range s.points[1:] {
+		if DistanceSquared(c, p) < DistanceSquared(c, best) {
+			best = p
+		}
+	}
+
+	return best
+}
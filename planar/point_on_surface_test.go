@@ -0,0 +1,84 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestPointOnSurface(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   geo.Geometry
+		result geo.Point
+	}{
+		{
+			name:   "c shape",
+			geom:   cShape,
+			result: geo.Point{1.5, 5},
+		},
+		{
+			name: "widest section of a multi polygon",
+			geom: geo.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 4}, {0, 4}, {0, 0}}},
+				{{{5, 0}, {9, 0}, {9, 1}, {5, 1}, {5, 0}}},
+			},
+			result: geo.Point{7, 0.5},
+		},
+		{
+			name: "hole in the middle",
+			geom: geo.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{1, 1}, {1, 9}, {8, 9}, {8, 1}, {1, 1}},
+			},
+			result: geo.Point{9, 5},
+		},
+		{
+			name:   "line string",
+			geom:   geo.LineString{{0, 0}, {1, 0}, {5, 0}, {10, 0}},
+			result: geo.Point{5, 0},
+		},
+		{
+			name:   "segment",
+			geom:   geo.LineString{{0, 0}, {10, 0}},
+			result: geo.Point{0, 0},
+		},
+		{
+			name:   "points",
+			geom:   geo.MultiPoint{{0, 0}, {4, 4}, {1, 1}},
+			result: geo.Point{1, 1},
+		},
+		{
+			name: "collection uses the area",
+			geom: geo.Collection{
+				geo.Point{100, 100},
+				geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{2, 2}},
+			},
+			result: geo.Point{1, 1},
+		},
+		{
+			name:   "flat polygon",
+			geom:   geo.Polygon{{{0, 0}, {1, 0}, {2, 0}, {0, 0}}},
+			result: geo.Point{1, 0},
+		},
+		{
+			name:   "empty",
+			geom:   geo.MultiPolygon{},
+			result: geo.Point{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if p := PointOnSurface(tc.geom); p != tc.result {
+				t.Errorf("incorrect point: %v != %v", p, tc.result)
+			}
+		})
+	}
+}
+
+func TestPointOnSurface_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		PointOnSurface(g)
+	}
+}
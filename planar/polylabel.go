@@ -0,0 +1,136 @@
+package planar
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// PoleOfInaccessibility returns the point inside the areas of the geometry that is
+// farthest from their boundary, and that distance, e.g. to place a label.
+// It uses the polylabel algorithm by Mapbox, https://github.com/mapbox/polylabel,
+// the result is within the precision of the optimum. The precision must be positive.
+// Geometries without an area return the PointOnSurface and zero.
+func PoleOfInaccessibility(g geo.Geometry, precision float64) (geo.Point, float64) {
+	if !(precision > 0) {
+		panic(fmt.Sprintf("precision must be positive: %v", precision))
+	}
+
+	var rings []geo.Ring
+	for _, p := range surfacePolygons(g) {
+		for _, r := range p {
+			if len(r) > 0 {
+				rings = append(rings, r)
+			}
+		}
+	}
+
+	b := geo.Bound{Min: geo.Point{math.Inf(1), math.Inf(1)}, Max: geo.Point{math.Inf(-1), math.Inf(-1)}}
+	for _, r := range rings {
+		b = b.Union(r.Bound())
+	}
+
+	size := math.Min(b.Max[0]-b.Min[0], b.Max[1]-b.Min[1])
+	if len(rings) == 0 || !(size > 0) {
+		return PointOnSurface(g), 0
+	}
+
+	// cover the bound with the initial cells
+	h := size / 2
+	queue := &polylabelQueue{}
+	for x := b.Min[0]; x < b.Max[0]; x += size {
+		for y := b.Min[1]; y < b.Max[1]; y += size {
+			heap.Push(queue, newPolylabelCell(geo.Point{x + h, y + h}, h, rings))
+		}
+	}
+
+	// the centroid and the center of the bound are good first guesses
+	centroid, _ := CentroidArea(g)
+	best := newPolylabelCell(centroid, 0, rings)
+	if c := newPolylabelCell(b.Center(), 0, rings); c.d > best.d {
+		best = c
+	}
+
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(*polylabelCell)
+		if cell.d > best.d {
+			best = cell
+		}
+
+		// there can not be a better point in this cell
+		if cell.max-best.d <= precision {
+			continue
+		}
+
+		h := cell.h / 2
+		for _, d := range [4]geo.Point{{-h, -h}, {h, -h}, {-h, h}, {h, h}} {
+			heap.Push(queue, newPolylabelCell(geo.Point{cell.c[0] + d[0], cell.c[1] + d[1]}, h, rings))
+		}
+	}
+
+	return best.c, best.d
+}
+
+type polylabelCell struct {
+	c   geo.Point // the center of the cell
+	h   float64   // half of the cell size
+	d   float64   // the distance from the center to the boundary, negative if outside
+	max float64   // the max distance to the boundary within the cell
+}
+
+func newPolylabelCell(c geo.Point, h float64, rings []geo.Ring) *polylabelCell {
+	d := signedRingsDistance(rings, c)
+	return &polylabelCell{c: c, h: h, d: d, max: d + h*math.Sqrt2}
+}
+
+// signedRingsDistance returns the distance from the point to the rings,
+// positive if the point is inside by the even-odd rule.
+func signedRingsDistance(rings []geo.Ring, p geo.Point) float64 {
+	inside := false
+	minSq := math.Inf(1)
+	for _, r := range rings {
+		for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+			a, b := r[i], r[j]
+			if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+				inside = !inside
+			}
+
+			minSq = math.Min(minSq, DistanceFromSegmentSquared(a, b, p))
+		}
+	}
+
+	if inside {
+		return math.Sqrt(minSq)
+	}
+
+	return -math.Sqrt(minSq)
+}
+
+// polylabelQueue is a max heap of the cells by their max distance.
+type polylabelQueue []*polylabelCell
+
+func (q polylabelQueue) Len() int {
+	return len(q)
+}
+
+func (q polylabelQueue) Less(i, j int) bool {
+	return q[i].max > q[j].max
+}
+
+func (q polylabelQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *polylabelQueue) Push(x interface{}) {
+	*q = append(*q, x.(*polylabelCell))
+}
+
+func (q *polylabelQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	c := old[n-1]
+	*q = old[:n-1]
+	return c
+}
@@ -0,0 +1,119 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+// cShape has its centroid in the notch.
+var cShape = geo.Polygon{{
+	{0, 0}, {10, 0}, {10, 3}, {3, 3}, {3, 7},
+	{10, 7}, {10, 10}, {0, 10}, {0, 0},
+}}
+
+func TestPoleOfInaccessibility(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     geo.Geometry
+		point    geo.Point // only checked if set
+		distance float64
+	}{
+		{
+			name:     "square",
+			geom:     geo.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			point:    geo.Point{5, 5},
+			distance: 5,
+		},
+		{
+			name: "c shape",
+			geom: cShape,
+			// the circle touches both sides of a corner and the inner corner
+			distance: 3 * math.Sqrt2 / (1 + math.Sqrt2),
+		},
+		{
+			name: "with hole",
+			geom: geo.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+			},
+			distance: 2 * math.Sqrt2 / (1 + math.Sqrt2),
+		},
+		{
+			name: "multi polygon",
+			geom: geo.MultiPolygon{
+				{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+				{{{10, 0}, {16, 0}, {16, 6}, {10, 6}, {10, 0}}},
+			},
+			point:    geo.Point{13, 3},
+			distance: 3,
+		},
+		{
+			name:     "bound",
+			geom:     geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{4, 2}},
+			distance: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, d := PoleOfInaccessibility(tc.geom, 0.001)
+			if math.Abs(d-tc.distance) > 0.001 {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if tc.point != (geo.Point{}) && DistanceSquared(p, tc.point) > 0.001 {
+				t.Errorf("incorrect point: %v != %v", p, tc.point)
+			}
+
+			if math.Abs(DistanceFrom(tc.geom, p)-d) > 1e-9 {
+				t.Errorf("distance does not match the point: %v %v", p, d)
+			}
+		})
+	}
+}
+
+func TestPoleOfInaccessibility_centroidOutside(t *testing.T) {
+	c, _ := CentroidArea(cShape)
+	if PolygonContains(cShape, c) {
+		t.Fatalf("centroid should be outside: %v", c)
+	}
+
+	p, _ := PoleOfInaccessibility(cShape, 0.1)
+	if !PolygonContains(cShape, p) {
+		t.Errorf("point should be inside: %v", p)
+	}
+}
+
+func TestPoleOfInaccessibility_noArea(t *testing.T) {
+	ls := geo.LineString{{0, 0}, {1, 1}, {2, 0}}
+	p, d := PoleOfInaccessibility(ls, 1)
+	if p != (geo.Point{1, 1}) || d != 0 {
+		t.Errorf("incorrect result: %v %v", p, d)
+	}
+
+	flat := geo.Polygon{{{0, 0}, {1, 0}, {2, 0}, {0, 0}}}
+	if _, d := PoleOfInaccessibility(flat, 1); d != 0 {
+		t.Errorf("incorrect distance: %v", d)
+	}
+}
+
+func TestPoleOfInaccessibility_precision(t *testing.T) {
+	for _, precision := range []float64{0, -1, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("should panic for precision: %v", precision)
+				}
+			}()
+			PoleOfInaccessibility(cShape, precision)
+		}()
+	}
+}
+
+func TestPoleOfInaccessibility_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		PoleOfInaccessibility(g, 1)
+	}
+}
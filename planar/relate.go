@@ -0,0 +1,355 @@
+package planar
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+)
+
+// Locations of a point relative to a geometry,
+// used to index the rows and columns of the intersection matrix.
+const (
+	interior = iota
+	boundary
+	exterior
+)
+
+// Relate returns the DE-9IM intersection matrix of the two geometries
+// as a 9 character string. The characters are the dimension,
+// or F if empty, of the intersection of the interior, boundary and exterior
+// of a with the interior, boundary and exterior of b, in row major order.
+// Line boundaries follow the mod-2 rule,
+// so closed lines have an empty boundary.
+func Relate(a, b geo.Geometry) string {
+	m := relate(a, b)
+
+	buf := make([]byte, 0, 9)
+	for i := range m {
+		for j := range m[i] {
+			if m[i][j] < 0 {
+				buf = append(buf, 'F')
+			} else {
+				buf = append(buf, byte('0'+m[i][j]))
+			}
+		}
+	}
+
+	return string(buf)
+}
+
+// Intersects returns true if the geometries have at least one point in common.
+func Intersects(a, b geo.Geometry) bool {
+	return !Disjoint(a, b)
+}
+
+// Disjoint returns true if the geometries have no point in common.
+func Disjoint(a, b geo.Geometry) bool {
+	return matches(relate(a, b), "FF*FF****")
+}
+
+// Touches returns true if the geometries have at least one point in common,
+// but their interiors do not intersect.
+func Touches(a, b geo.Geometry) bool {
+	if dimension(a) == 0 && dimension(b) == 0 {
+		return false
+	}
+
+	m := relate(a, b)
+	return matches(m, "FT*******") ||
+		matches(m, "F**T*****") ||
+		matches(m, "F***T****")
+}
+
+// Crosses returns true if the geometries have some but not all interior
+// points in common and the dimension of the intersection
+// is less than the maximum dimension of the geometries.
+func Crosses(a, b geo.Geometry) bool {
+	da, db := dimension(a), dimension(b)
+	m := relate(a, b)
+	switch {
+	case da == 1 && db == 1:
+		return matches(m, "0********")
+	case da < db:
+		return matches(m, "T*T******")
+	case da > db:
+		return matches(m, "T*****T**")
+	}
+
+	return false
+}
+
+// Within returns true if a lies in the interior of b.
+func Within(a, b geo.Geometry) bool {
+	return matches(relate(a, b), "T*F**F***")
+}
+
+// Contains returns true if b lies in the interior of a.
+// Unlike PolygonContains, b does not lie in a
+// if it is completely on the boundary of a.
+func Contains(a, b geo.Geometry) bool {
+	return matches(relate(a, b), "T*****FF*")
+}
+
+// Covers returns true if no point of b lies in the exterior of a.
+// Unlike Contains, b may lie completely on the boundary of a.
+func Covers(a, b geo.Geometry) bool {
+	m := relate(a, b)
+	return matches(m, "T*****FF*") ||
+		matches(m, "*T****FF*") ||
+		matches(m, "***T**FF*") ||
+		matches(m, "****T*FF*")
+}
+
+// Overlaps returns true if the geometries have the same dimension,
+// have some but not all points in common and
+// the intersection is also of the same dimension.
+func Overlaps(a, b geo.Geometry) bool {
+	da, db := dimension(a), dimension(b)
+	if da != db {
+		return false
+	}
+
+	if da == 1 {
+		return matches(relate(a, b), "1*T***T**")
+	}
+
+	return matches(relate(a, b), "T*T***T**")
+}
+
+type matrix [3][3]int
+
+func (m *matrix) set(i, j, dim int) {
+	if dim > m[i][j] {
+		m[i][j] = dim
+	}
+}
+
+// matches checks the matrix against a pattern of
+// T, F, *, 0, 1 and 2 characters.
+func matches(m matrix, pattern string) bool {
+	for k := 0; k < 9; k++ {
+		v := m[k/3][k%3]
+		switch pattern[k] {
+		case 'T':
+			if v < 0 {
+				return false
+			}
+		case 'F':
+			if v >= 0 {
+				return false
+			}
+		case '0', '1', '2':
+			if v != int(pattern[k]-'0') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// relate nodes all the linework of the geometries together
+// and locates every vertex, edge and face of the arrangement
+// relative to both geometries.
+func relate(a, b geo.Geometry) matrix {
+	m := matrix{{-1, -1, -1}, {-1, -1, -1}, {-1, -1, -1}}
+	m[exterior][exterior] = 2
+
+	segs := relateSegments(nil, a, 0)
+	segs = relateSegments(segs, b, 1)
+	if len(segs) == 0 {
+		return m
+	}
+
+	g := newGraph(segs)
+	incident := make(map[int][]int)
+	sides := make([][2][2]bool, len(g.edges))
+	for i, e := range g.edges {
+		incident[e.u] = append(incident[e.u], i)
+		incident[e.v] = append(incident[e.v], i)
+
+		var loc [2]int
+		for input := 0; input < 2; input++ {
+			l, r := g.winding(e, input)
+			sides[i][input] = [2]bool{l > 0, r > 0}
+			loc[input] = edgeLocation(e, input, sides[i][input])
+		}
+
+		m.set(loc[0], loc[1], 1)
+		for side := 0; side < 2; side++ {
+			m.set(areaLocation(sides[i][0][side]), areaLocation(sides[i][1][side]), 2)
+		}
+	}
+
+	for v := range g.vertices {
+		m.set(
+			g.vertexLocation(v, 0, incident[v], sides),
+			g.vertexLocation(v, 1, incident[v], sides),
+			0,
+		)
+	}
+
+	return m
+}
+
+func areaLocation(inside bool) int {
+	if inside {
+		return interior
+	}
+
+	return exterior
+}
+
+func edgeLocation(e *edge, input int, sides [2]bool) int {
+	if sides[0] != sides[1] {
+		return boundary
+	}
+
+	if sides[0] || e.lines[input] {
+		return interior
+	}
+
+	return exterior
+}
+
+func (g *graph) vertexLocation(v, input int, incident []int, sides [][2][2]bool) int {
+	inside := false
+	if len(incident) == 0 {
+		inside = g.windingAt(g.vertices[v], input, nil) > 0
+	} else {
+		for _, i := range incident {
+			if s := sides[i][input]; s[0] != s[1] {
+				return boundary
+			}
+		}
+
+		// not on the boundary of the area so
+		// all the faces around the vertex are the same
+		inside = sides[incident[0]][input][0]
+	}
+
+	if inside {
+		return interior
+	}
+
+	ends := g.lineEnds[v][input]
+	if ends%2 == 1 {
+		return boundary
+	}
+
+	if ends > 0 || g.points[v][input] {
+		return interior
+	}
+
+	for _, i := range incident {
+		if g.edges[i].lines[input] {
+			return interior
+		}
+	}
+
+	return exterior
+}
+
+// relateSegments appends the segments of all the parts of the geometry.
+func relateSegments(segs []*segment, g geo.Geometry, input int) []*segment {
+	switch g := g.(type) {
+	case nil:
+	case geo.Point:
+		segs = append(segs, &segment{a: g, b: g, input: input, kind: kindPoint})
+	case geo.MultiPoint:
+		for _, p := range g {
+			segs = relateSegments(segs, p, input)
+		}
+	case geo.LineString:
+		segs = lineSegments(segs, g, input)
+	case geo.MultiLineString:
+		for _, ls := range g {
+			segs = lineSegments(segs, ls, input)
+		}
+	case geo.Collection:
+		for _, c := range g {
+			segs = relateSegments(segs, c, input)
+		}
+	case geo.Ring, geo.Polygon, geo.MultiPolygon, geo.Bound:
+		segs = areaSegments(segs, g, input)
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+
+	return segs
+}
+
+func lineSegments(segs []*segment, ls geo.LineString, input int) []*segment {
+	points := make([]geo.Point, 0, len(ls))
+	for i, p := range ls {
+		if i == 0 || p != ls[i-1] {
+			points = append(points, p)
+		}
+	}
+
+	if len(points) == 0 {
+		return segs
+	}
+
+	if len(points) == 1 {
+		// degenerate line, treat it as a point
+		return append(segs, &segment{a: points[0], b: points[0], input: input, kind: kindPoint})
+	}
+
+	for i := 0; i < len(points)-1; i++ {
+		segs = append(segs, &segment{
+			a:     points[i],
+			b:     points[i+1],
+			input: input,
+			kind:  kindLine,
+			start: i == 0,
+			end:   i == len(points)-2,
+		})
+	}
+
+	return segs
+}
+
+// dimension returns the max dimension of the non-empty parts of the geometry.
+// Returns -1 if the geometry is empty.
+func dimension(g geo.Geometry) int {
+	switch g := g.(type) {
+	case nil:
+		return -1
+	case geo.MultiPoint:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.LineString:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.MultiLineString:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.Ring:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.Polygon:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.MultiPolygon:
+		if len(g) == 0 {
+			return -1
+		}
+	case geo.Collection:
+		max := -1
+		for _, c := range g {
+			if d := dimension(c); d > max {
+				max = d
+			}
+		}
+
+		return max
+	}
+
+	return g.Dimensions()
+}
@@ -0,0 +1,233 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestRelate(t *testing.T) {
+	square := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	cases := []struct {
+		name   string
+		a, b   geo.Geometry
+		result string
+	}{
+		{
+			name:   "overlapping polygons",
+			a:      square,
+			b:      geo.Polygon{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}},
+			result: "212101212",
+		},
+		{
+			name:   "polygons with shared edge",
+			a:      square,
+			b:      geo.Polygon{{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}}},
+			result: "FF2F11212",
+		},
+		{
+			name:   "equal polygons",
+			a:      square,
+			b:      geo.Bound{Max: geo.Point{2, 2}},
+			result: "2FFF1FFF2",
+		},
+		{
+			name:   "point inside polygon",
+			a:      square,
+			b:      geo.Point{1, 1},
+			result: "0F2FF1FF2",
+		},
+		{
+			name:   "point on polygon boundary",
+			a:      square,
+			b:      geo.Point{2, 1},
+			result: "FF20F1FF2",
+		},
+		{
+			name:   "point outside polygon",
+			a:      square,
+			b:      geo.Point{5, 1},
+			result: "FF2FF10F2",
+		},
+		{
+			name:   "line crossing polygon",
+			a:      square,
+			b:      geo.LineString{{-1, 1}, {3, 1}},
+			result: "1F20F1102",
+		},
+		{
+			name:   "crossing lines",
+			a:      geo.LineString{{0, 0}, {2, 2}},
+			b:      geo.LineString{{0, 2}, {2, 0}},
+			result: "0F1FF0102",
+		},
+		{
+			name:   "overlapping lines",
+			a:      geo.LineString{{0, 0}, {2, 2}},
+			b:      geo.LineString{{1, 1}, {3, 3}},
+			result: "1010F0102",
+		},
+		{
+			name:   "closed line has no boundary",
+			a:      geo.LineString{{0, 0}, {2, 0}, {2, 2}, {0, 0}},
+			b:      geo.Point{0, 0},
+			result: "0F1FFFFF2",
+		},
+		{
+			name:   "multi point",
+			a:      geo.MultiPoint{{0, 0}, {1, 1}},
+			b:      geo.Point{0, 0},
+			result: "0F0FFFFF2",
+		},
+		{
+			name:   "polygon in hole",
+			a:      geo.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}},
+			b:      geo.Bound{Min: geo.Point{4, 4}, Max: geo.Point{6, 6}},
+			result: "FF2FF1212",
+		},
+		{
+			name:   "collection",
+			a:      geo.Collection{square, geo.LineString{{2, 1}, {4, 1}}},
+			b:      geo.Point{3, 1},
+			result: "0F2FF1FF2",
+		},
+		{
+			name:   "empty",
+			a:      nil,
+			b:      square,
+			result: "FFFFFF212",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Relate(tc.a, tc.b); v != tc.result {
+				t.Errorf("incorrect matrix: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestRelate_allGeometries(t *testing.T) {
+	for _, a := range geo.AllGeometries {
+		for _, b := range geo.AllGeometries {
+			// should not panic with unsupported type
+			Relate(a, b)
+		}
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	square := geo.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+	cases := []struct {
+		name       string
+		a, b       geo.Geometry
+		intersects bool
+		touches    bool
+		crosses    bool
+		within     bool
+		contains   bool
+		covers     bool
+		overlaps   bool
+	}{
+		{
+			name:       "overlapping polygons",
+			a:          square,
+			b:          geo.Bound{Min: geo.Point{1, 1}, Max: geo.Point{3, 3}},
+			intersects: true,
+			overlaps:   true,
+		},
+		{
+			name:       "touching polygons",
+			a:          square,
+			b:          geo.Bound{Min: geo.Point{2, 0}, Max: geo.Point{3, 3}},
+			intersects: true,
+			touches:    true,
+		},
+		{
+			name:       "polygon inside",
+			a:          square,
+			b:          geo.Bound{Min: geo.Point{0.5, 0.5}, Max: geo.Point{1.5, 1.5}},
+			intersects: true,
+			contains:   true,
+			covers:     true,
+		},
+		{
+			name:       "polygon within",
+			a:          geo.Bound{Min: geo.Point{0.5, 0.5}, Max: geo.Point{1.5, 1.5}},
+			b:          square,
+			intersects: true,
+			within:     true,
+		},
+		{
+			name:       "line on boundary",
+			a:          square,
+			b:          geo.LineString{{0, 0}, {2, 0}},
+			intersects: true,
+			touches:    true,
+			covers:     true,
+		},
+		{
+			name:       "line crossing polygon",
+			a:          geo.LineString{{-1, 1}, {3, 1}},
+			b:          square,
+			intersects: true,
+			crosses:    true,
+		},
+		{
+			name:       "crossing lines",
+			a:          geo.LineString{{0, 0}, {2, 2}},
+			b:          geo.LineString{{0, 2}, {2, 0}},
+			intersects: true,
+			crosses:    true,
+		},
+		{
+			name:       "overlapping lines",
+			a:          geo.LineString{{0, 0}, {2, 2}},
+			b:          geo.LineString{{1, 1}, {3, 3}},
+			intersects: true,
+			overlaps:   true,
+		},
+		{
+			name: "disjoint",
+			a:    square,
+			b:    geo.Point{3, 3},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Intersects(tc.a, tc.b); v != tc.intersects {
+				t.Errorf("incorrect intersects: %v != %v", v, tc.intersects)
+			}
+
+			if v := Disjoint(tc.a, tc.b); v == tc.intersects {
+				t.Errorf("incorrect disjoint: %v != %v", v, !tc.intersects)
+			}
+
+			if v := Touches(tc.a, tc.b); v != tc.touches {
+				t.Errorf("incorrect touches: %v != %v", v, tc.touches)
+			}
+
+			if v := Crosses(tc.a, tc.b); v != tc.crosses {
+				t.Errorf("incorrect crosses: %v != %v", v, tc.crosses)
+			}
+
+			if v := Within(tc.a, tc.b); v != tc.within {
+				t.Errorf("incorrect within: %v != %v", v, tc.within)
+			}
+
+			if v := Contains(tc.a, tc.b); v != tc.contains {
+				t.Errorf("incorrect contains: %v != %v", v, tc.contains)
+			}
+
+			if v := Covers(tc.a, tc.b); v != tc.covers {
+				t.Errorf("incorrect covers: %v != %v", v, tc.covers)
+			}
+
+			if v := Overlaps(tc.a, tc.b); v != tc.overlaps {
+				t.Errorf("incorrect overlaps: %v != %v", v, tc.overlaps)
+			}
+		})
+	}
+}
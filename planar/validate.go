@@ -0,0 +1,457 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// InvalidReason is the reason why a geometry is not valid.
+type InvalidReason int
+
+const (
+	InvalidCoordinate    InvalidReason = iota + 1 // a coordinate is NaN or infinite
+	TooFewPoints                                  // a line has less than 2 distinct points or a ring less than 3
+	RingNotClosed                                 // the first and last points of a ring are different
+	DuplicatePoints                               // two consecutive points are the same
+	SelfIntersection                              // a ring crosses or touches itself
+	RingIntersection                              // two rings of a polygon cross or overlap
+	HoleOutsideShell                              // a hole is not inside the shell of its polygon
+	NestedHoles                                   // a hole is inside another hole of the same polygon
+	DisconnectedInterior                          // the holes split the interior of a polygon
+	NestedShells                                  // the interiors of two polygons of a multi-polygon intersect
+	WrongOrientation                              // a shell is not counter-clockwise or a hole is not clockwise
+)
+
+var invalidReasons = map[InvalidReason]string{
+	InvalidCoordinate:    "invalid coordinate",
+	TooFewPoints:         "too few points",
+	RingNotClosed:        "ring not closed",
+	DuplicatePoints:      "duplicate points",
+	SelfIntersection:     "ring self-intersection",
+	RingIntersection:     "ring intersection",
+	HoleOutsideShell:     "hole outside shell",
+	NestedHoles:          "nested holes",
+	DisconnectedInterior: "disconnected interior",
+	NestedShells:         "nested shells",
+	WrongOrientation:     "wrong orientation",
+}
+
+// String returns a human readable description of the reason.
+func (r InvalidReason) String() string {
+	if s, ok := invalidReasons[r]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("unknown reason %d", int(r))
+}
+
+// ValidationError describes why and where a geometry is invalid.
+// Polygon and Ring are the indexes of the polygon in a multi-polygon
+// and of the ring in the polygon, they are -1 if not applicable.
+type ValidationError struct {
+	Reason   InvalidReason
+	Location geo.Point
+	Polygon  int
+	Ring     int
+}
+
+// Error returns the reason and location of the problem.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("planar: invalid geometry, %v at %v", e.Reason, e.Location)
+}
+
+// IsValid returns true if the geometry passes all the checks of Validate.
+func IsValid(g geo.Geometry) bool {
+	return Validate(g) == nil
+}
+
+// Validate checks that the geometry follows the OGC simple features rules
+// and the orientation convention of the library, i.e. counter-clockwise
+// shells and clockwise holes. Consecutive duplicate points are also rejected.
+// Returns nil if the geometry is valid or a *ValidationError for the first problem found.
+func Validate(g geo.Geometry) error {
+	if err := validate(g); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validate(g geo.Geometry) *ValidationError {
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case geo.Point:
+		return validatePoints(geo.MultiPoint{g})
+	case geo.MultiPoint:
+		return validatePoints(g)
+	case geo.LineString:
+		return validateLineString(g)
+	case geo.MultiLineString:
+		for _, ls := range g {
+			if err := validateLineString(ls); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case geo.Ring:
+		return validatePolygons(geo.MultiPolygon{{g}}, false)
+	case geo.Polygon:
+		return validatePolygons(geo.MultiPolygon{g}, false)
+	case geo.MultiPolygon:
+		return validatePolygons(g, true)
+	case geo.Collection:
+		for _, c := range g {
+			if err := validate(c); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case geo.Bound:
+		return validatePoints(geo.MultiPoint{g.Min, g.Max})
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func validatePoints(mp geo.MultiPoint) *ValidationError {
+	for _, p := range mp {
+		if !finite(p) {
+			return &ValidationError{Reason: InvalidCoordinate, Location: p, Polygon: -1, Ring: -1}
+		}
+	}
+
+	return nil
+}
+
+func validateLineString(ls geo.LineString) *ValidationError {
+	if err := validatePoints(geo.MultiPoint(ls)); err != nil {
+		return err
+	}
+
+	for i := 1; i < len(ls); i++ {
+		if ls[i] == ls[i-1] {
+			return &ValidationError{Reason: DuplicatePoints, Location: ls[i], Polygon: -1, Ring: -1}
+		}
+	}
+
+	if len(ls) < 2 {
+		var p geo.Point
+		if len(ls) > 0 {
+			p = ls[0]
+		}
+
+		return &ValidationError{Reason: TooFewPoints, Location: p, Polygon: -1, Ring: -1}
+	}
+
+	return nil
+}
+
+// ringSegment is a segment of a ring of a (multi-)polygon
+// used to find the intersections between the rings.
+type ringSegment struct {
+	a, b        geo.Point
+	poly, ring  int
+	index, size int
+}
+
+// touch is a point where two rings of a polygon touch.
+type touch struct {
+	rings [2]int
+	p     geo.Point
+}
+
+func validatePolygons(mp geo.MultiPolygon, multi bool) *ValidationError {
+	index := func(i int) int {
+		if multi {
+			return i
+		}
+
+		return -1
+	}
+
+	var segs []ringSegment
+	for i, p := range mp {
+		for j, r := range p {
+			if err := validateRing(r); err != nil {
+				err.Polygon = index(i)
+				err.Ring = j
+				return err
+			}
+
+			for k := 0; k < len(r)-1; k++ {
+				segs = append(segs, ringSegment{
+					a: r[k], b: r[k+1],
+					poly: i, ring: j,
+					index: k, size: len(r) - 1,
+				})
+			}
+		}
+	}
+
+	var (
+		err     *ValidationError
+		touches = make([][]touch, len(mp))
+	)
+
+	report := func(reason InvalidReason, p geo.Point, s ringSegment) {
+		if err == nil || reason < err.Reason {
+			err = &ValidationError{Reason: reason, Location: p, Polygon: index(s.poly), Ring: s.ring}
+		}
+	}
+
+	forEachSegmentPair(segs, func(s1, s2 ringSegment) {
+		kind, p := segmentIntersection(s1.a, s1.b, s2.a, s2.b)
+		if kind == noIntersection {
+			return
+		}
+
+		if s1.poly == s2.poly && s1.ring == s2.ring {
+			if adjacentSegments(s1, s2) && kind == pointIntersection {
+				// only share the common vertex
+				return
+			}
+
+			report(SelfIntersection, p, s1)
+		} else if s1.poly == s2.poly {
+			if kind == collinearIntersection || properIntersection(s1, s2, p) {
+				report(RingIntersection, p, s2)
+				return
+			}
+
+			touches[s1.poly] = append(touches[s1.poly], touch{rings: [2]int{s1.ring, s2.ring}, p: p})
+		} else if kind == collinearIntersection || properIntersection(s1, s2, p) {
+			report(NestedShells, p, s2)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for i, p := range mp {
+		if err := validateHoles(p, touches[i]); err != nil {
+			err.Polygon = index(i)
+			return err
+		}
+	}
+
+	for i := range mp {
+		for j := range mp {
+			if i == j || len(mp[j]) == 0 || len(mp[i]) == 0 || !mp[i].Bound().Intersects(mp[j].Bound()) {
+				continue
+			}
+
+			if p, ok := ringInside(mp[j][0], func(p geo.Point) int { return polygonLocation(mp[i], p) }); ok {
+				return &ValidationError{Reason: NestedShells, Location: p, Polygon: index(j), Ring: 0}
+			}
+		}
+	}
+
+	for i, p := range mp {
+		for j, r := range p {
+			o := r.Orientation()
+			if (j == 0 && o != geo.CCW) || (j > 0 && o != geo.CW) {
+				return &ValidationError{Reason: WrongOrientation, Location: r[0], Polygon: index(i), Ring: j}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateRing(r geo.Ring) *ValidationError {
+	if err := validatePoints(geo.MultiPoint(r)); err != nil {
+		return err
+	}
+
+	if len(r) > 0 && r[0] != r[len(r)-1] {
+		return &ValidationError{Reason: RingNotClosed, Location: r[0]}
+	}
+
+	for i := 1; i < len(r); i++ {
+		if r[i] == r[i-1] {
+			return &ValidationError{Reason: DuplicatePoints, Location: r[i]}
+		}
+	}
+
+	if len(r) < 4 {
+		var p geo.Point
+		if len(r) > 0 {
+			p = r[0]
+		}
+
+		return &ValidationError{Reason: TooFewPoints, Location: p}
+	}
+
+	return nil
+}
+
+// validateHoles checks the holes are inside the shell and not nested,
+// and that the rings touching each other do not disconnect the interior.
+func validateHoles(p geo.Polygon, touches []touch) *ValidationError {
+	if len(p) == 0 {
+		return nil
+	}
+
+	for i := 1; i < len(p); i++ {
+		loc := func(pt geo.Point) int { return ringLocation(p[0], pt) }
+		if pt, ok := ringOutside(p[i], loc); ok {
+			return &ValidationError{Reason: HoleOutsideShell, Location: pt, Ring: i}
+		}
+
+		for j := 1; j < len(p); j++ {
+			if i == j || !p[i].Bound().Intersects(p[j].Bound()) {
+				continue
+			}
+
+			loc := func(pt geo.Point) int { return ringLocation(p[j], pt) }
+			if pt, ok := ringInside(p[i], loc); ok {
+				return &ValidationError{Reason: NestedHoles, Location: pt, Ring: i}
+			}
+		}
+	}
+
+	// The interior is disconnected if the graph of rings
+	// and the points where they touch has a cycle.
+	ids := make(map[geo.Point]int)
+	parent := make(map[int]int)
+	var find func(x int) int
+	find = func(x int) int {
+		if p, ok := parent[x]; ok && p != x {
+			parent[x] = find(p)
+			return parent[x]
+		}
+
+		return x
+	}
+
+	seen := make(map[[2]int]bool)
+	for _, t := range touches {
+		id, ok := ids[t.p]
+		if !ok {
+			id = len(p) + len(ids)
+			ids[t.p] = id
+		}
+
+		for _, r := range t.rings {
+			if seen[[2]int{r, id}] {
+				continue
+			}
+
+			seen[[2]int{r, id}] = true
+			a, b := find(r), find(id)
+			if a == b {
+				return &ValidationError{Reason: DisconnectedInterior, Location: t.p, Ring: r}
+			}
+
+			parent[a] = b
+		}
+	}
+
+	return nil
+}
+
+// ringInside returns a vertex or segment midpoint of the ring
+// that is in the interior according to the location function.
+func ringInside(r geo.Ring, loc func(geo.Point) int) (geo.Point, bool) {
+	return ringPointAt(r, loc, interior)
+}
+
+// ringOutside returns a vertex or segment midpoint of the ring
+// that is in the exterior according to the location function.
+func ringOutside(r geo.Ring, loc func(geo.Point) int) (geo.Point, bool) {
+	return ringPointAt(r, loc, exterior)
+}
+
+func ringPointAt(r geo.Ring, loc func(geo.Point) int, location int) (geo.Point, bool) {
+	for i, p := range r {
+		if loc(p) == location {
+			return p, true
+		}
+
+		if i > 0 {
+			mid := geo.Point{(p[0] + r[i-1][0]) / 2, (p[1] + r[i-1][1]) / 2}
+			if loc(mid) == location {
+				return mid, true
+			}
+		}
+	}
+
+	return geo.Point{}, false
+}
+
+// ringLocation returns if the point is in the interior,
+// on the boundary or in the exterior of the ring.
+func ringLocation(r geo.Ring, p geo.Point) int {
+	if !r.Bound().Contains(p) {
+		return exterior
+	}
+
+	for i := 0; i < len(r)-1; i++ {
+		if onSegmentExact(r[i], r[i+1], p) {
+			return boundary
+		}
+	}
+
+	if RingContains(r, p) {
+		return interior
+	}
+
+	return exterior
+}
+
+// polygonLocation returns if the point is in the interior,
+// on the boundary or in the exterior of the polygon.
+func polygonLocation(p geo.Polygon, pt geo.Point) int {
+	loc := ringLocation(p[0], pt)
+	if loc != interior {
+		return loc
+	}
+
+	for _, h := range p[1:] {
+		switch ringLocation(h, pt) {
+		case interior:
+			return exterior
+		case boundary:
+			return boundary
+		}
+	}
+
+	return interior
+}
+
+func adjacentSegments(s1, s2 ringSegment) bool {
+	d := s1.index - s2.index
+	return d == 1 || d == -1 || d == s1.size-1 || d == 1-s1.size
+}
+
+// properIntersection returns true if the intersection point
+// is not a vertex of either segment, i.e. the rings cross.
+func properIntersection(s1, s2 ringSegment, p geo.Point) bool {
+	return p != s1.a && p != s1.b && p != s2.a && p != s2.b &&
+		!onSegmentExact(s1.a, s1.b, s2.a) && !onSegmentExact(s1.a, s1.b, s2.b) &&
+		!onSegmentExact(s2.a, s2.b, s1.a) && !onSegmentExact(s2.a, s2.b, s1.b)
+}
+
+// forEachSegmentPair calls the function for every pair
+// of segments whose bounding boxes intersect.
+func forEachSegmentPair(segs []ringSegment, fn func(s1, s2 ringSegment)) {
+	bounds := make([]geo.Bound, len(segs))
+	for i, s := range segs {
+		bounds[i] = segmentBound(s.a, s.b)
+	}
+
+	sweepPairs(bounds, func(i, j int) {
+		fn(segs[i], segs[j])
+	})
+}
+
+func finite(p geo.Point) bool {
+	return !math.IsNaN(p[0]) && !math.IsNaN(p[1]) &&
+		!math.IsInf(p[0], 0) && !math.IsInf(p[1], 0)
+}
@@ -0,0 +1,178 @@
+package planar
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestValidate(t *testing.T) {
+	shell := geo.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	cases := []struct {
+		name     string
+		geom     geo.Geometry
+		reason   InvalidReason
+		location geo.Point
+	}{
+		{
+			name: "valid polygon",
+			geom: geo.Polygon{shell, {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}},
+		},
+		{
+			name: "valid holes touching at a point",
+			geom: geo.Polygon{shell, {{2, 2}, {2, 8}, {5, 5}, {2, 2}}, {{5, 5}, {8, 8}, {8, 2}, {5, 5}}},
+		},
+		{
+			name: "valid multi polygon touching at a point",
+			geom: geo.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+				{{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}},
+			},
+		},
+		{
+			name:     "nan coordinate",
+			geom:     geo.LineString{{0, 0}, {math.Inf(1), 1}},
+			reason:   InvalidCoordinate,
+			location: geo.Point{math.Inf(1), 1},
+		},
+		{
+			name:     "line too short",
+			geom:     geo.LineString{{1, 2}},
+			reason:   TooFewPoints,
+			location: geo.Point{1, 2},
+		},
+		{
+			name:     "ring too short",
+			geom:     geo.Ring{{0, 0}, {1, 0}, {0, 0}},
+			reason:   TooFewPoints,
+			location: geo.Point{0, 0},
+		},
+		{
+			name:     "not closed",
+			geom:     geo.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			reason:   RingNotClosed,
+			location: geo.Point{0, 0},
+		},
+		{
+			name:     "duplicate points",
+			geom:     geo.Polygon{{{0, 0}, {1, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+			reason:   DuplicatePoints,
+			location: geo.Point{1, 0},
+		},
+		{
+			name:     "bow tie",
+			geom:     geo.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			reason:   SelfIntersection,
+			location: geo.Point{1, 1},
+		},
+		{
+			name:     "spike",
+			geom:     geo.Polygon{{{0, 0}, {2, 0}, {3, 0}, {2, 0}, {2, 2}, {0, 0}}},
+			reason:   SelfIntersection,
+			location: geo.Point{2, 0},
+		},
+		{
+			name:     "self touching ring",
+			geom:     geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 0}, {0, 4}, {0, 0}}},
+			reason:   SelfIntersection,
+			location: geo.Point{2, 0},
+		},
+		{
+			name:     "crossing hole",
+			geom:     geo.Polygon{shell, {{5, 5}, {5, 15}, {6, 15}, {6, 5}, {5, 5}}},
+			reason:   RingIntersection,
+			location: geo.Point{5, 10},
+		},
+		{
+			name:     "hole outside shell",
+			geom:     geo.Polygon{shell, {{20, 20}, {20, 21}, {21, 21}, {21, 20}, {20, 20}}},
+			reason:   HoleOutsideShell,
+			location: geo.Point{20, 20},
+		},
+		{
+			name:     "nested holes",
+			geom:     geo.Polygon{shell, {{1, 1}, {1, 9}, {9, 9}, {9, 1}, {1, 1}}, {{2, 2}, {2, 3}, {3, 3}, {3, 2}, {2, 2}}},
+			reason:   NestedHoles,
+			location: geo.Point{2, 2},
+		},
+		{
+			name:     "disconnected interior",
+			geom:     geo.Polygon{shell, {{0, 5}, {5, 10}, {10, 5}, {5, 0}, {0, 5}}},
+			reason:   DisconnectedInterior,
+			location: geo.Point{5, 10},
+		},
+		{
+			name: "nested shells",
+			geom: geo.MultiPolygon{
+				{shell},
+				{{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}},
+			},
+			reason:   NestedShells,
+			location: geo.Point{1, 1},
+		},
+		{
+			name: "shells sharing an edge",
+			geom: geo.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+				{{{1, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 0}}},
+			},
+			reason:   NestedShells,
+			location: geo.Point{1, 0},
+		},
+		{
+			name:     "clockwise shell",
+			geom:     geo.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}},
+			reason:   WrongOrientation,
+			location: geo.Point{0, 0},
+		},
+		{
+			name:     "counter clockwise hole",
+			geom:     geo.Polygon{shell, {{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}},
+			reason:   WrongOrientation,
+			location: geo.Point{2, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.geom)
+			if tc.reason == 0 {
+				if err != nil {
+					t.Fatalf("should be valid: %v", err)
+				}
+
+				if !IsValid(tc.geom) {
+					t.Errorf("should be valid")
+				}
+
+				return
+			}
+
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+
+			if verr.Reason != tc.reason {
+				t.Errorf("incorrect reason: %v != %v", verr.Reason, tc.reason)
+			}
+
+			if verr.Location != tc.location {
+				t.Errorf("incorrect location: %v != %v", verr.Location, tc.location)
+			}
+
+			if IsValid(tc.geom) {
+				t.Errorf("should not be valid")
+			}
+		})
+	}
+}
+
+func TestValidate_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		// should not panic with unsupported type
+		Validate(g)
+	}
+}
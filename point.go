@@ -1,5 +1,7 @@
 package geo
 
+import "math"
+
 var _ Pointer = Point{}
 
 // Point is a Lon/Lat 2d point.
@@ -49,3 +51,13 @@ func (p Point) Dimensions() int {
 func (p Point) Bound() Bound {
 	return Bound{p, p}
 }
+
+// WrapLon returns the longitude wrapped into [-180, 180), e.g. 190 is -170.
+func WrapLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+
+	return lon - 180
+}
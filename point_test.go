@@ -29,3 +29,15 @@ func TestPointEqual(t *testing.T) {
 		t.Errorf("expected: %v != %v", p3, p4)
 	}
 }
+
+func TestWrapLon(t *testing.T) {
+	cases := map[float64]float64{
+		0: 0, 179: 179, 180: -180, -180: -180, 190: -170, -190: 170, 540: -180, -725: -5,
+	}
+
+	for lon, expected := range cases {
+		if v := WrapLon(lon); v != expected {
+			t.Errorf("incorrect longitude for %v: %v != %v", lon, v, expected)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// LambertConformalConic is a Lambert Conformal Conic projection on an ellipsoid
+// with two standard parallels, or one if they are equal, as in EPSG guidance note 7-2.
+// FromWGS84 and ToWGS84 are the geo.Projection pair for use with project.Geometry.
+type LambertConformalConic struct {
+	Ellipsoid         geodesic.Ellipsoid
+	StandardParallel1 float64 // in degrees
+	StandardParallel2 float64 // in degrees
+	CentralMeridian   float64 // the longitude of the origin in degrees
+	LatitudeOfOrigin  float64 // in degrees
+	FalseEasting      float64 // in meters
+	FalseNorthing     float64 // in meters
+}
+
+// FromWGS84 projects the lon/lat point to easting and northing in meters.
+func (p LambertConformalConic) FromWGS84(g geo.Point) geo.Point {
+	e, n, af, r0 := p.constants()
+	r := af * math.Pow(tsfn(deg2rad(g[1]), e), n)
+	if g[1] == 90*math.Copysign(1, n) {
+		r = 0
+	}
+
+	theta := n * deg2rad(geo.WrapLon(g[0]-p.CentralMeridian))
+	return geo.Point{
+		p.FalseEasting + r*math.Sin(theta),
+		p.FalseNorthing + r0 - r*math.Cos(theta),
+	}
+}
+
+// ToWGS84 returns the lon/lat of the point with easting and northing in meters.
+func (p LambertConformalConic) ToWGS84(g geo.Point) geo.Point {
+	e, n, af, r0 := p.constants()
+	x, y := g[0]-p.FalseEasting, r0-(g[1]-p.FalseNorthing)
+	if n < 0 {
+		x, y = -x, -y
+	}
+
+	r := math.Copysign(math.Hypot(x, y), n)
+	theta := math.Atan2(x, y)
+	if r == 0 {
+		return geo.Point{p.CentralMeridian, 90 * math.Copysign(1, n)}
+	}
+
+	return geo.Point{
+		geo.WrapLon(rad2deg(theta/n) + p.CentralMeridian),
+		rad2deg(latitudeFromT(math.Pow(r/af, 1/n), e)),
+	}
+}
+
+// constants returns the eccentricity, the cone constant n, a·F and the radius at the origin.
+func (p LambertConformalConic) constants() (e, n, af, r0 float64) {
+	e = math.Sqrt(p.Ellipsoid.E2())
+	phi1, phi2 := deg2rad(p.StandardParallel1), deg2rad(p.StandardParallel2)
+	m1, t1 := msfn(phi1, e), tsfn(phi1, e)
+	if phi1 == phi2 {
+		n = math.Sin(phi1)
+	} else {
+		n = (math.Log(m1) - math.Log(msfn(phi2, e))) / (math.Log(t1) - math.Log(tsfn(phi2, e)))
+	}
+
+	af = p.Ellipsoid.A * m1 / (n * math.Pow(t1, n))
+	r0 = af * math.Pow(tsfn(deg2rad(p.LatitudeOfOrigin), e), n)
+	return e, n, af, r0
+}
+
+// AlbersEqualArea is an Albers Equal Area conic projection on an ellipsoid
+// with two standard parallels, as in Snyder (1987) "Map Projections: A Working Manual".
+// FromWGS84 and ToWGS84 are the geo.Projection pair for use with project.Geometry.
+type AlbersEqualArea struct {
+	Ellipsoid         geodesic.Ellipsoid
+	StandardParallel1 float64 // in degrees
+	StandardParallel2 float64 // in degrees
+	CentralMeridian   float64 // the longitude of the origin in degrees
+	LatitudeOfOrigin  float64 // in degrees
+	FalseEasting      float64 // in meters
+	FalseNorthing     float64 // in meters
+}
+
+// FromWGS84 projects the lon/lat point to easting and northing in meters.
+func (p AlbersEqualArea) FromWGS84(g geo.Point) geo.Point {
+	e, n, c, rho0 := p.constants()
+	rho := p.Ellipsoid.A * math.Sqrt(math.Max(0, c-n*qsfn(deg2rad(g[1]), e))) / n
+	theta := n * deg2rad(geo.WrapLon(g[0]-p.CentralMeridian))
+	return geo.Point{
+		p.FalseEasting + rho*math.Sin(theta),
+		p.FalseNorthing + rho0 - rho*math.Cos(theta),
+	}
+}
+
+// ToWGS84 returns the lon/lat of the point with easting and northing in meters.
+func (p AlbersEqualArea) ToWGS84(g geo.Point) geo.Point {
+	e, n, c, rho0 := p.constants()
+	x, y := g[0]-p.FalseEasting, rho0-(g[1]-p.FalseNorthing)
+	if n < 0 {
+		x, y = -x, -y
+	}
+
+	rho := math.Hypot(x, y)
+	a := p.Ellipsoid.A
+	q := (c - rho*rho*n*n/(a*a)) / n
+	return geo.Point{
+		geo.WrapLon(rad2deg(math.Atan2(x, y)/n) + p.CentralMeridian),
+		rad2deg(latitudeFromQ(q, e)),
+	}
+}
+
+// constants returns the eccentricity, the cone constant n, C and the radius at the origin.
+func (p AlbersEqualArea) constants() (e, n, c, rho0 float64) {
+	e = math.Sqrt(p.Ellipsoid.E2())
+	phi1, phi2 := deg2rad(p.StandardParallel1), deg2rad(p.StandardParallel2)
+	m1, q1 := msfn(phi1, e), qsfn(phi1, e)
+	if phi1 == phi2 {
+		n = math.Sin(phi1)
+	} else {
+		m2 := msfn(phi2, e)
+		n = (m1*m1 - m2*m2) / (qsfn(phi2, e) - q1)
+	}
+
+	c = m1*m1 + n*q1
+	rho0 = p.Ellipsoid.A * math.Sqrt(c-n*qsfn(deg2rad(p.LatitudeOfOrigin), e)) / n
+	return e, n, c, rho0
+}
+
+// msfn returns m, the radius of the parallel of the latitude on the unit ellipsoid.
+func msfn(phi, e float64) float64 {
+	s := e * math.Sin(phi)
+	return math.Cos(phi) / math.Sqrt(1-s*s)
+}
+
+// tsfn returns t of the Lambert Conformal Conic for the latitude.
+func tsfn(phi, e float64) float64 {
+	s := e * math.Sin(phi)
+	return math.Tan(math.Pi/4-phi/2) / math.Pow((1-s)/(1+s), e/2)
+}
+
+// latitudeFromT returns the latitude for t of the Lambert Conformal Conic.
+func latitudeFromT(t, e float64) float64 {
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < 15; i++ {
+		s := e * math.Sin(phi)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-s)/(1+s), e/2))
+		if math.Abs(next-phi) < 1e-14 {
+			return next
+		}
+
+		phi = next
+	}
+
+	return phi
+}
+
+// qsfn returns q, the authalic function of the latitude.
+func qsfn(phi, e float64) float64 {
+	s := math.Sin(phi)
+	if e == 0 {
+		return 2 * s
+	}
+
+	es := e * s
+	return (1 - e*e) * (s/(1-es*es) - math.Log((1-es)/(1+es))/(2*e))
+}
+
+// latitudeFromQ returns the latitude for q of the authalic function.
+func latitudeFromQ(q, e float64) float64 {
+	if e == 0 {
+		return math.Asin(math.Max(-1, math.Min(1, q/2)))
+	}
+
+	// q at the poles, where the iteration does not converge
+	qp := qsfn(math.Pi/2, e)
+	if math.Abs(q) >= qp {
+		return math.Copysign(math.Pi/2, q)
+	}
+
+	e2 := e * e
+	phi := math.Asin(q / 2)
+	for i := 0; i < 15; i++ {
+		s := math.Sin(phi)
+		es := e * s
+		d := (1 - es*es) * (1 - es*es) / (2 * math.Cos(phi)) *
+			(q/(1-e2) - s/(1-es*es) + math.Log((1-es)/(1+es))/(2*e))
+		phi += d
+		if math.Abs(d) < 1e-14 {
+			break
+		}
+	}
+
+	return phi
+}
@@ -0,0 +1,146 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// usFoot is the US survey foot in meters.
+const usFoot = 1200.0 / 3937
+
+func TestLambertConformalConic(t *testing.T) {
+	// Texas South Central from EPSG guidance note 7-2, in US survey feet
+	p := LambertConformalConic{
+		Ellipsoid:         geodesic.NewEllipsoid(6378206.400, 294.97870),
+		StandardParallel1: 28 + 23.0/60,
+		StandardParallel2: 30 + 17.0/60,
+		CentralMeridian:   -99,
+		LatitudeOfOrigin:  27 + 50.0/60,
+		FalseEasting:      2000000 * usFoot,
+	}
+
+	g := geo.Point{-96, 28.5}
+	expected := geo.Point{2963503.91 * usFoot, 254759.80 * usFoot}
+
+	v := p.FromWGS84(g)
+	if math.Abs(v[0]-expected[0]) > 0.01 || math.Abs(v[1]-expected[1]) > 0.01 {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	v = p.ToWGS84(expected)
+	if math.Abs(v[0]-g[0]) > 1e-7 || math.Abs(v[1]-g[1]) > 1e-7 {
+		t.Errorf("incorrect inverse: %v != %v", v, g)
+	}
+}
+
+func TestLambertConformalConic_roundTrip(t *testing.T) {
+	projections := map[string]LambertConformalConic{
+		"lambert 93": {
+			Ellipsoid:         geodesic.GRS80,
+			StandardParallel1: 49,
+			StandardParallel2: 44,
+			CentralMeridian:   3,
+			LatitudeOfOrigin:  46.5,
+			FalseEasting:      700000,
+			FalseNorthing:     6600000,
+		},
+		"one standard parallel": {
+			Ellipsoid:         geodesic.WGS84,
+			StandardParallel1: 45,
+			StandardParallel2: 45,
+			LatitudeOfOrigin:  45,
+		},
+		"southern hemisphere": {
+			Ellipsoid:         geodesic.WGS84,
+			StandardParallel1: -30,
+			StandardParallel2: -40,
+			CentralMeridian:   135,
+			LatitudeOfOrigin:  -35,
+		},
+	}
+
+	for name, p := range projections {
+		t.Run(name, func(t *testing.T) {
+			for _, g := range []geo.Point{
+				{p.CentralMeridian, p.LatitudeOfOrigin}, {p.CentralMeridian + 10, p.LatitudeOfOrigin + 5}, {p.CentralMeridian - 20, 0},
+			} {
+				v := p.ToWGS84(p.FromWGS84(g))
+				if math.Abs(v[0]-g[0]) > 1e-9 || math.Abs(v[1]-g[1]) > 1e-9 {
+					t.Errorf("incorrect round trip: %v != %v", v, g)
+				}
+			}
+		})
+	}
+
+	// the origin is at the false easting and northing
+	p := projections["lambert 93"]
+	if v := p.FromWGS84(geo.Point{3, 46.5}); math.Abs(v[0]-700000) > 1e-6 || math.Abs(v[1]-6600000) > 1e-6 {
+		t.Errorf("incorrect origin: %v", v)
+	}
+}
+
+func TestAlbersEqualArea(t *testing.T) {
+	// the example from Snyder (1987), page 292
+	p := AlbersEqualArea{
+		Ellipsoid:         geodesic.NewEllipsoid(6378206.4, 294.978698),
+		StandardParallel1: 29.5,
+		StandardParallel2: 45.5,
+		CentralMeridian:   -96,
+		LatitudeOfOrigin:  23,
+	}
+
+	g := geo.Point{-75, 35}
+	expected := geo.Point{1885472.7, 1535925.0}
+
+	v := p.FromWGS84(g)
+	if math.Abs(v[0]-expected[0]) > 0.1 || math.Abs(v[1]-expected[1]) > 0.1 {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	v = p.ToWGS84(p.FromWGS84(g))
+	if math.Abs(v[0]-g[0]) > 1e-9 || math.Abs(v[1]-g[1]) > 1e-9 {
+		t.Errorf("incorrect inverse: %v != %v", v, g)
+	}
+}
+
+func TestAlbersEqualArea_area(t *testing.T) {
+	// equal area, a one degree cell has the same area as on the ellipsoid
+	p := AlbersEqualArea{
+		Ellipsoid:         geodesic.WGS84,
+		StandardParallel1: 29.5,
+		StandardParallel2: 45.5,
+		CentralMeridian:   -96,
+		LatitudeOfOrigin:  23,
+	}
+
+	cell := geo.Polygon{{{-100, 40}, {-99, 40}, {-99, 41}, {-100, 41}, {-100, 40}}}
+	ring := geo.Ring{}
+	for lat := 40.0; lat <= 41; lat += 0.01 {
+		ring = append(ring, p.FromWGS84(geo.Point{-100, lat}))
+	}
+	for lon := -100.0; lon <= -99; lon += 0.01 {
+		ring = append(ring, p.FromWGS84(geo.Point{lon, 41}))
+	}
+	for lat := 41.0; lat >= 40; lat -= 0.01 {
+		ring = append(ring, p.FromWGS84(geo.Point{-99, lat}))
+	}
+	for lon := -99.0; lon >= -100; lon -= 0.01 {
+		ring = append(ring, p.FromWGS84(geo.Point{lon, 40}))
+	}
+	ring = append(ring, ring[0])
+
+	var area float64
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	area = math.Abs(area / 2)
+
+	// the ring follows the parallels and geodesic.Area the geodesics,
+	// they differ by less than the tolerance for a cell this size
+	if e := geodesic.Area(cell); math.Abs(area-e)/e > 1e-4 {
+		t.Errorf("incorrect area: %v != %v", area, e)
+	}
+}
@@ -0,0 +1,102 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// Helmert is a seven parameter Helmert transformation of earth centered, earth fixed
+// coordinates, in the position vector convention of EPSG method 9606.
+// Rotations in the coordinate frame convention, EPSG method 9607, have the opposite sign.
+type Helmert struct {
+	TX, TY, TZ float64 // the translations in meters
+	RX, RY, RZ float64 // the rotations in arc seconds
+	S          float64 // the scale difference in parts per million
+}
+
+// Apply transforms the earth centered, earth fixed coordinates.
+func (t Helmert) Apply(x, y, z float64) (float64, float64, float64) {
+	const arcSecond = math.Pi / (180 * 3600)
+	rx, ry, rz := t.RX*arcSecond, t.RY*arcSecond, t.RZ*arcSecond
+	s := 1 + t.S*1e-6
+
+	return t.TX + s*(x-rz*y+ry*z),
+		t.TY + s*(rz*x+y-rx*z),
+		t.TZ + s*(-ry*x+rx*y+z)
+}
+
+// Inverse returns the reverse transformation, by negating the parameters,
+// which is accurate to millimeters for the small rotations between datums.
+func (t Helmert) Inverse() Helmert {
+	return Helmert{
+		TX: -t.TX, TY: -t.TY, TZ: -t.TZ,
+		RX: -t.RX, RY: -t.RY, RZ: -t.RZ,
+		S: -t.S,
+	}
+}
+
+// Datum is a geodetic datum, its ellipsoid and the Helmert transformation to WGS84.
+// ToWGS84 and FromWGS84 convert lon/lat between the datum and WGS84
+// through earth centered, earth fixed coordinates.
+type Datum struct {
+	Ellipsoid geodesic.Ellipsoid
+	Helmert   Helmert // to WGS84
+}
+
+var (
+	// DatumWGS84 is the World Geodetic System 1984.
+	DatumWGS84 = Datum{Ellipsoid: geodesic.WGS84}
+
+	// DatumOSGB36 is the Ordnance Survey of Great Britain 1936 datum, EPSG:1314,
+	// accurate to a couple of meters.
+	DatumOSGB36 = Datum{
+		Ellipsoid: geodesic.NewEllipsoid(6377563.396, 299.3249646),
+		Helmert: Helmert{
+			TX: 446.448, TY: -125.157, TZ: 542.060,
+			RX: 0.1502, RY: 0.2470, RZ: 0.8421,
+			S: -20.4894,
+		},
+	}
+
+	// DatumED50 is the European Datum 1950, EPSG:1133, accurate to a few meters.
+	DatumED50 = Datum{
+		Ellipsoid: geodesic.NewEllipsoid(6378388, 297),
+		Helmert:   Helmert{TX: -87, TY: -98, TZ: -121},
+	}
+
+	// DatumNAD27 is the North American Datum 1927 for the contiguous United States,
+	// EPSG:1173, accurate to about 10 meters.
+	DatumNAD27 = Datum{
+		Ellipsoid: geodesic.NewEllipsoid(6378206.4, 294.9786982),
+		Helmert:   Helmert{TX: -8, TY: 160, TZ: 176},
+	}
+)
+
+// ToWGS84 converts the lon/lat on the datum to WGS84 lon/lat.
+func (d Datum) ToWGS84(p geo.Point) geo.Point {
+	return transformDatum(p, d.Ellipsoid, d.Helmert, geodesic.WGS84)
+}
+
+// FromWGS84 converts the WGS84 lon/lat to lon/lat on the datum.
+func (d Datum) FromWGS84(p geo.Point) geo.Point {
+	return transformDatum(p, geodesic.WGS84, d.Helmert.Inverse(), d.Ellipsoid)
+}
+
+// DatumShift returns the projection of lon/lat from one datum to the other through WGS84.
+func DatumShift(from, to Datum) geo.Projection {
+	return func(p geo.Point) geo.Point {
+		return to.FromWGS84(from.ToWGS84(p))
+	}
+}
+
+func transformDatum(p geo.Point, from geodesic.Ellipsoid, t Helmert, to geodesic.Ellipsoid) geo.Point {
+	if t == (Helmert{}) && from == to {
+		return p
+	}
+
+	x, y, z := t.Apply(toECEF(from, p[0], p[1], 0))
+	lon, lat, _ := fromECEF(to, x, y, z)
+	return geo.Point{lon, lat}
+}
@@ -0,0 +1,48 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestHelmert(t *testing.T) {
+	// WGS 72 to WGS 84 from EPSG guidance note 7-2
+	h := Helmert{TZ: 4.5, RZ: 0.554, S: 0.219}
+
+	x, y, z := h.Apply(3657660.66, 255768.55, 5201382.11)
+	if math.Abs(x-3657660.78) > 0.01 || math.Abs(y-255778.43) > 0.01 || math.Abs(z-5201387.75) > 0.01 {
+		t.Errorf("incorrect transformation: %v %v %v", x, y, z)
+	}
+
+	x, y, z = h.Inverse().Apply(x, y, z)
+	if math.Abs(x-3657660.66) > 0.01 || math.Abs(y-255768.55) > 0.01 || math.Abs(z-5201382.11) > 0.01 {
+		t.Errorf("incorrect inverse: %v %v %v", x, y, z)
+	}
+}
+
+func TestDatum(t *testing.T) {
+	for _, d := range []Datum{DatumOSGB36, DatumED50, DatumNAD27} {
+		g := geo.Point{-1.5, 52.5}
+		v := d.ToWGS84(d.FromWGS84(g))
+		if math.Abs(v[0]-g[0]) > 1e-7 || math.Abs(v[1]-g[1]) > 1e-7 {
+			t.Errorf("incorrect round trip: %v != %v", v, g)
+		}
+
+		if v := d.FromWGS84(g); v == g {
+			t.Errorf("should shift: %v", v)
+		}
+	}
+
+	g := geo.Point{1, 2}
+	if v := DatumWGS84.ToWGS84(g); v != g {
+		t.Errorf("should not change: %v", v)
+	}
+
+	// ED50 to NAD27 through WGS84 and back
+	shift, back := DatumShift(DatumED50, DatumNAD27), DatumShift(DatumNAD27, DatumED50)
+	if v := back(shift(g)); math.Abs(v[0]-g[0]) > 1e-7 || math.Abs(v[1]-g[1]) > 1e-7 {
+		t.Errorf("incorrect round trip: %v != %v", v, g)
+	}
+}
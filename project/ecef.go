@@ -0,0 +1,58 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// ToECEF returns the earth centered, earth fixed coordinates in meters of the WGS84 lon/lat point
+// at the altitude in meters above the ellipsoid. X points to lon/lat 0,0, Z to the north pole.
+func ToECEF(p geo.Point, alt float64) (x, y, z float64) {
+	return toECEF(geodesic.WGS84, p[0], p[1], alt)
+}
+
+// FromECEF returns the WGS84 lon/lat point and the altitude in meters above the ellipsoid
+// of the earth centered, earth fixed coordinates in meters.
+func FromECEF(x, y, z float64) (geo.Point, float64) {
+	lon, lat, alt := fromECEF(geodesic.WGS84, x, y, z)
+	return geo.Point{lon, lat}, alt
+}
+
+// toECEF returns the earth centered, earth fixed coordinates in meters
+// of the lon/lat in degrees and the height in meters above the ellipsoid.
+func toECEF(e geodesic.Ellipsoid, lon, lat, h float64) (x, y, z float64) {
+	e2 := e.E2()
+	phi, lambda := deg2rad(lat), deg2rad(lon)
+	sin := math.Sin(phi)
+	n := e.A / math.Sqrt(1-e2*sin*sin)
+
+	x = (n + h) * math.Cos(phi) * math.Cos(lambda)
+	y = (n + h) * math.Cos(phi) * math.Sin(lambda)
+	z = (n*(1-e2) + h) * sin
+	return x, y, z
+}
+
+// fromECEF returns the lon/lat in degrees and the height in meters above the ellipsoid
+// of the earth centered, earth fixed coordinates.
+func fromECEF(e geodesic.Ellipsoid, x, y, z float64) (lon, lat, h float64) {
+	e2 := e.E2()
+	p := math.Hypot(x, y)
+	phi := math.Atan2(z, p*(1-e2))
+	for i := 0; i < 10; i++ {
+		sin := math.Sin(phi)
+		n := e.A / math.Sqrt(1-e2*sin*sin)
+		next := math.Atan2(z+e2*n*sin, p)
+		if math.Abs(next-phi) < 1e-15 {
+			phi = next
+			break
+		}
+
+		phi = next
+	}
+
+	sin := math.Sin(phi)
+	h = p*math.Cos(phi) + z*sin - e.A*math.Sqrt(1-e2*sin*sin)
+	return rad2deg(math.Atan2(y, x)), rad2deg(phi), h
+}
@@ -0,0 +1,34 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestECEF(t *testing.T) {
+	cases := []struct {
+		lon, lat, h float64
+	}{
+		{0, 0, 0},
+		{2.12955, 53.80939444, 73},
+		{-122.416667, 37.783333, -20},
+		{179.9, -45, 8848},
+		{0, 90, 100},
+		{0, -90, 0},
+	}
+
+	for _, tc := range cases {
+		p, h := FromECEF(ToECEF(geo.Point{tc.lon, tc.lat}, tc.h))
+		if math.Abs(p[0]-tc.lon) > 1e-9 || math.Abs(p[1]-tc.lat) > 1e-9 || math.Abs(h-tc.h) > 1e-6 {
+			t.Errorf("incorrect round trip: %v %v != %v", p, h, tc)
+		}
+	}
+
+	// from EPSG guidance note 7-2
+	x, y, z := ToECEF(geo.Point{2.12955, 53.80939444}, 73)
+	if math.Abs(x-3771793.97) > 0.01 || math.Abs(y-140253.34) > 0.01 || math.Abs(z-5124304.35) > 0.01 {
+		t.Errorf("incorrect ecef: %v %v %v", x, y, z)
+	}
+}
@@ -0,0 +1,42 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// EllipsoidalMercator is the Mercator projection on an ellipsoid, EPSG method 9804.
+// Web maps use the spherical WGS84.ToMercator instead.
+// FromWGS84 and ToWGS84 are the geo.Projection pair for use with project.Geometry.
+type EllipsoidalMercator struct {
+	Ellipsoid       geodesic.Ellipsoid
+	CentralMeridian float64 // in degrees
+	ScaleFactor     float64 // on the equator
+	FalseEasting    float64 // in meters
+	FalseNorthing   float64 // in meters
+}
+
+// FromWGS84 projects the lon/lat point to easting and northing in meters.
+// Latitudes are bounded like the spherical projection, the poles are at infinity.
+func (m EllipsoidalMercator) FromWGS84(p geo.Point) geo.Point {
+	e := math.Sqrt(m.Ellipsoid.E2())
+	k := m.Ellipsoid.A * m.ScaleFactor
+	y := -k * math.Log(tsfn(deg2rad(p[1]), e))
+	return geo.Point{
+		m.FalseEasting + k*deg2rad(geo.WrapLon(p[0]-m.CentralMeridian)),
+		m.FalseNorthing + math.Max(-k*math.Pi, math.Min(y, k*math.Pi)),
+	}
+}
+
+// ToWGS84 returns the lon/lat of the point with easting and northing in meters.
+func (m EllipsoidalMercator) ToWGS84(p geo.Point) geo.Point {
+	e := math.Sqrt(m.Ellipsoid.E2())
+	k := m.Ellipsoid.A * m.ScaleFactor
+	t := math.Exp(-(p[1] - m.FalseNorthing) / k)
+	return geo.Point{
+		geo.WrapLon(rad2deg((p[0]-m.FalseEasting)/k) + m.CentralMeridian),
+		rad2deg(latitudeFromT(t, e)),
+	}
+}
@@ -0,0 +1,59 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+func TestEllipsoidalMercator(t *testing.T) {
+	// Makassar / NEIEZ from EPSG guidance note 7-2
+	m := EllipsoidalMercator{
+		Ellipsoid:       geodesic.NewEllipsoid(6377397.155, 299.15281),
+		CentralMeridian: 110,
+		ScaleFactor:     0.997,
+		FalseEasting:    3900000,
+		FalseNorthing:   900000,
+	}
+
+	g := geo.Point{120, -3}
+	expected := geo.Point{5009726.58, 569150.82}
+
+	v := m.FromWGS84(g)
+	if math.Abs(v[0]-expected[0]) > 0.01 || math.Abs(v[1]-expected[1]) > 0.01 {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	v = m.ToWGS84(expected)
+	if math.Abs(v[0]-g[0]) > 1e-7 || math.Abs(v[1]-g[1]) > 1e-7 {
+		t.Errorf("incorrect inverse: %v != %v", v, g)
+	}
+}
+
+func TestWorldMercator(t *testing.T) {
+	for _, g := range []geo.Point{{0, 0}, {10, 45}, {-179.5, -70}, {135, 84}} {
+		v := WorldMercator.ToWGS84(WGS84.ToWorldMercator(g))
+		if math.Abs(v[0]-g[0]) > 1e-9 || math.Abs(v[1]-g[1]) > 1e-9 {
+			t.Errorf("incorrect round trip: %v != %v", v, g)
+		}
+	}
+
+	// the same eastings as web mercator, but smaller northings
+	g := geo.Point{10, 45}
+	web, world := WGS84.ToMercator(g), WGS84.ToWorldMercator(g)
+	if math.Abs(web[0]-world[0]) > 1e-6 {
+		t.Errorf("incorrect easting: %v != %v", world[0], web[0])
+	}
+
+	if math.Abs(world[1]-5591295.92) > 0.01 {
+		t.Errorf("incorrect northing: %v", world[1])
+	}
+
+	// poles are bounded
+	v := WGS84.ToWorldMercator(geo.Point{0, 90})
+	if math.IsInf(v[1], 0) || math.IsNaN(v[1]) {
+		t.Errorf("should be bounded: %v", v)
+	}
+}
@@ -0,0 +1,100 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// ENU is a local east, north, up tangent plane frame in meters with its origin
+// at a WGS84 lon/lat point on the ellipsoid. FromWGS84 and ToWGS84 are the geo.Projection pair
+// for use with project.Geometry, so planar algorithms work in meters over small areas.
+type ENU struct {
+	Origin geo.Point
+}
+
+// FromECEF returns the east, north and up of the earth centered, earth fixed coordinates.
+func (f ENU) FromECEF(x, y, z float64) (east, north, up float64) {
+	ox, oy, oz := ToECEF(f.Origin, 0)
+	sinLon, cosLon, sinLat, cosLat := f.rotation()
+	dx, dy, dz := x-ox, y-oy, z-oz
+
+	east = -sinLon*dx + cosLon*dy
+	north = -sinLat*cosLon*dx - sinLat*sinLon*dy + cosLat*dz
+	up = cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+	return east, north, up
+}
+
+// ToECEF returns the earth centered, earth fixed coordinates of the east, north and up.
+func (f ENU) ToECEF(east, north, up float64) (x, y, z float64) {
+	ox, oy, oz := ToECEF(f.Origin, 0)
+	sinLon, cosLon, sinLat, cosLat := f.rotation()
+
+	x = ox - sinLon*east - sinLat*cosLon*north + cosLat*cosLon*up
+	y = oy + cosLon*east - sinLat*sinLon*north + cosLat*sinLon*up
+	z = oz + cosLat*north + sinLat*up
+	return x, y, z
+}
+
+// FromLonLatAlt returns the east, north and up of the lon/lat point
+// at the altitude in meters above the ellipsoid.
+func (f ENU) FromLonLatAlt(p geo.Point, alt float64) (east, north, up float64) {
+	return f.FromECEF(ToECEF(p, alt))
+}
+
+// ToLonLatAlt returns the lon/lat point and the altitude in meters above the ellipsoid
+// of the east, north and up.
+func (f ENU) ToLonLatAlt(east, north, up float64) (geo.Point, float64) {
+	return FromECEF(f.ToECEF(east, north, up))
+}
+
+// FromWGS84 projects the lon/lat point on the ellipsoid to east and north in meters,
+// dropping the up that is below the plane away from the origin.
+func (f ENU) FromWGS84(p geo.Point) geo.Point {
+	east, north, _ := f.FromLonLatAlt(p, 0)
+	return geo.Point{east, north}
+}
+
+// ToWGS84 returns the lon/lat of the point on the ellipsoid with the east and north in meters.
+func (f ENU) ToWGS84(p geo.Point) geo.Point {
+	// the up of the point on the ellipsoid, found by iteration as up is almost along the normal
+	var up float64
+	for i := 0; i < 5; i++ {
+		q, alt := f.ToLonLatAlt(p[0], p[1], up)
+		if math.Abs(alt) < 1e-6 {
+			return q
+		}
+
+		_, _, up = f.FromLonLatAlt(q, 0)
+	}
+
+	q, _ := f.ToLonLatAlt(p[0], p[1], up)
+	return q
+}
+
+// ToENU projects the geometry into the east, north, up frame centered on its bound,
+// taking care of the antimeridian, and returns the frame to project it back with FromENU.
+// Like Geometry it modifies the geometry in place.
+func ToENU(g geo.Geometry) (geo.Geometry, ENU) {
+	var origin geo.Point
+	if g != nil {
+		if b := geo.NewBoundWrapped(g); !b.IsEmpty() {
+			origin = b.Center()
+		}
+	}
+
+	f := ENU{Origin: origin}
+	return Geometry(g, f.FromWGS84), f
+}
+
+// FromENU projects the geometry in the east, north, up frame back to lon/lat.
+// Like Geometry it modifies the geometry in place.
+func FromENU(g geo.Geometry, f ENU) geo.Geometry {
+	return Geometry(g, f.ToWGS84)
+}
+
+// rotation returns the sines and cosines of the longitude and latitude of the origin.
+func (f ENU) rotation() (sinLon, cosLon, sinLat, cosLat float64) {
+	lon, lat := deg2rad(f.Origin[0]), deg2rad(f.Origin[1])
+	return math.Sin(lon), math.Cos(lon), math.Sin(lat), math.Cos(lat)
+}
@@ -0,0 +1,80 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestENU(t *testing.T) {
+	f := ENU{Origin: geo.Point{-122.416667, 37.783333}}
+
+	e, n, u := f.FromLonLatAlt(f.Origin, 10)
+	if math.Abs(e) > 1e-6 || math.Abs(n) > 1e-6 || math.Abs(u-10) > 1e-6 {
+		t.Errorf("origin should be up: %v %v %v", e, n, u)
+	}
+
+	// a point to the north east at about 1 km
+	p := geo.Point{-122.405, 37.7925}
+	e, n, u = f.FromLonLatAlt(p, 50)
+	if e < 1000 || e > 1100 || n < 1000 || n > 1100 {
+		t.Errorf("incorrect east and north: %v %v", e, n)
+	}
+
+	// the earth curves away from the plane
+	if u > 50 || u < 49.8 {
+		t.Errorf("incorrect up: %v", u)
+	}
+
+	d := geodesic.Distance(f.Origin, p)
+	if h := math.Hypot(e, n); math.Abs(h-d) > 0.1 {
+		t.Errorf("incorrect distance: %v != %v", h, d)
+	}
+
+	q, alt := f.ToLonLatAlt(e, n, u)
+	if math.Abs(q[0]-p[0]) > 1e-9 || math.Abs(q[1]-p[1]) > 1e-9 || math.Abs(alt-50) > 1e-6 {
+		t.Errorf("incorrect round trip: %v %v", q, alt)
+	}
+}
+
+func TestENU_roundTrip(t *testing.T) {
+	for _, origin := range []geo.Point{{0, 0}, {10, 52}, {179.9, -45}, {0, 90}} {
+		f := ENU{Origin: origin}
+		for _, p := range []geo.Point{{1000, 2000}, {-5000, 300}, {20000, -20000}} {
+			v := f.FromWGS84(f.ToWGS84(p))
+			if math.Abs(v[0]-p[0]) > 1e-6 || math.Abs(v[1]-p[1]) > 1e-6 {
+				t.Errorf("incorrect round trip for %v: %v != %v", origin, v, p)
+			}
+		}
+	}
+}
+
+func TestToENU(t *testing.T) {
+	// a 0.01 degree square across the antimeridian
+	poly := geo.Polygon{{{179.995, 10}, {-179.995, 10}, {-179.995, 10.01}, {179.995, 10.01}, {179.995, 10}}}
+
+	g, f := ToENU(poly.Clone())
+	if c := f.Origin; math.Abs(math.Abs(c[0])-180) > 1e-9 || math.Abs(c[1]-10.005) > 1e-9 {
+		t.Errorf("incorrect origin: %v", c)
+	}
+
+	area := planar.Area(g)
+	expected := math.Abs(geodesic.Area(poly))
+	if math.Abs(area-expected)/expected > 1e-4 {
+		t.Errorf("incorrect area: %v != %v", area, expected)
+	}
+
+	g = FromENU(g, f)
+	for i, p := range g.(geo.Polygon)[0] {
+		if math.Abs(geo.WrapLon(p[0]-poly[0][i][0])) > 1e-9 || math.Abs(p[1]-poly[0][i][1]) > 1e-9 {
+			t.Errorf("incorrect round trip: %v != %v", p, poly[0][i])
+		}
+	}
+
+	if g, _ := ToENU(nil); g != nil {
+		t.Errorf("should be nil: %v", g)
+	}
+}
@@ -0,0 +1,155 @@
+package project
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// ErrUnknownSRID is returned when transforming from or to an SRID that is not registered.
+var ErrUnknownSRID = errors.New("project: unknown srid")
+
+// Pair is a pair of projections between WGS84 lon/lat and a coordinate reference system.
+type Pair struct {
+	FromWGS84 geo.Projection
+	ToWGS84   geo.Projection
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[int]Pair{}
+)
+
+func init() {
+	wgs84 := Pair{FromWGS84: identity, ToWGS84: identity}
+
+	// geographic lon/lat, ETRS89 and NAD83 are within a couple of meters of WGS84
+	registry[4326] = wgs84
+	registry[4258] = wgs84
+	registry[4269] = wgs84
+
+	mercator := Pair{FromWGS84: WGS84.ToMercator, ToWGS84: Mercator.ToWGS84}
+	registry[3857] = mercator
+	registry[900913] = mercator
+	registry[3395] = Pair{FromWGS84: WGS84.ToWorldMercator, ToWGS84: WorldMercator.ToWGS84}
+
+	for zone := 1; zone <= 60; zone++ {
+		north, south := UTM(zone, false), UTM(zone, true)
+		registry[32600+zone] = Pair{FromWGS84: north.FromWGS84, ToWGS84: north.ToWGS84}
+		registry[32700+zone] = Pair{FromWGS84: south.FromWGS84, ToWGS84: south.ToWGS84}
+	}
+
+	// ETRS89 / UTM zones 28N to 38N on GRS80
+	for zone := 28; zone <= 38; zone++ {
+		tm := utm(geodesic.GRS80, zone, false)
+		registry[25800+zone] = Pair{FromWGS84: tm.FromWGS84, ToWGS84: tm.ToWGS84}
+	}
+
+	// RGF93 / Lambert-93
+	lambert93 := LambertConformalConic{
+		Ellipsoid:         geodesic.GRS80,
+		StandardParallel1: 49,
+		StandardParallel2: 44,
+		CentralMeridian:   3,
+		LatitudeOfOrigin:  46.5,
+		FalseEasting:      700000,
+		FalseNorthing:     6600000,
+	}
+	registry[2154] = Pair{FromWGS84: lambert93.FromWGS84, ToWGS84: lambert93.ToWGS84}
+
+	// OSGB36 / British National Grid
+	bng := NewTransverseMercator(DatumOSGB36.Ellipsoid, -2, 49, 0.9996012717, 400000, -100000)
+	registry[27700] = Pair{
+		FromWGS84: func(p geo.Point) geo.Point { return bng.FromWGS84(DatumOSGB36.FromWGS84(p)) },
+		ToWGS84:   func(p geo.Point) geo.Point { return DatumOSGB36.ToWGS84(bng.ToWGS84(p)) },
+	}
+
+	// ETRS89-extended / LCC Europe and LAEA Europe
+	lcc := LambertConformalConic{
+		Ellipsoid:         geodesic.GRS80,
+		StandardParallel1: 35,
+		StandardParallel2: 65,
+		CentralMeridian:   10,
+		LatitudeOfOrigin:  52,
+		FalseEasting:      4000000,
+		FalseNorthing:     2800000,
+	}
+	registry[3034] = Pair{FromWGS84: lcc.FromWGS84, ToWGS84: lcc.ToWGS84}
+
+	laea := LambertAzimuthalEqualArea{
+		Ellipsoid:        geodesic.GRS80,
+		CentralMeridian:  10,
+		LatitudeOfOrigin: 52,
+		FalseEasting:     4321000,
+		FalseNorthing:    3210000,
+	}
+	registry[3035] = Pair{FromWGS84: laea.FromWGS84, ToWGS84: laea.ToWGS84}
+
+	// NAD83 / Conus Albers and GDA94 / Australian Albers
+	conus := AlbersEqualArea{
+		Ellipsoid:         geodesic.GRS80,
+		StandardParallel1: 29.5,
+		StandardParallel2: 45.5,
+		CentralMeridian:   -96,
+		LatitudeOfOrigin:  23,
+	}
+	registry[5070] = Pair{FromWGS84: conus.FromWGS84, ToWGS84: conus.ToWGS84}
+
+	australia := AlbersEqualArea{
+		Ellipsoid:         geodesic.GRS80,
+		StandardParallel1: -18,
+		StandardParallel2: -36,
+		CentralMeridian:   132,
+	}
+	registry[3577] = Pair{FromWGS84: australia.FromWGS84, ToWGS84: australia.ToWGS84}
+}
+
+// Register adds or replaces the projections of the SRID, e.g. an EPSG code.
+func Register(srid int, p Pair) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[srid] = p
+}
+
+// Lookup returns the projections of the SRID and if it is registered.
+// It includes WGS84 (4326), Web Mercator (3857), World Mercator (3395), the WGS84 UTM zones (326xx and 327xx),
+// ETRS89 UTM (25828 to 25838), Lambert-93 (2154), British National Grid (27700),
+// ETRS89 LCC and LAEA Europe (3034 and 3035), Conus Albers (5070) and Australian Albers (3577).
+// Datums within a couple of meters of WGS84, like ETRS89 and NAD83, are taken to be WGS84.
+func Lookup(srid int) (Pair, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	p, ok := registry[srid]
+	return p, ok
+}
+
+// Transform projects the geometry from one SRID to another through WGS84 lon/lat,
+// e.g. with the SRID from ewkb.Unmarshal. Like Geometry it modifies the geometry in place.
+// Returns ErrUnknownSRID if either SRID is not registered.
+func Transform(g geo.Geometry, fromSRID, toSRID int) (geo.Geometry, error) {
+	from, ok := Lookup(fromSRID)
+	if !ok {
+		return nil, ErrUnknownSRID
+	}
+
+	to, ok := Lookup(toSRID)
+	if !ok {
+		return nil, ErrUnknownSRID
+	}
+
+	if fromSRID == toSRID {
+		return g, nil
+	}
+
+	return Geometry(g, func(p geo.Point) geo.Point {
+		return to.FromWGS84(from.ToWGS84(p))
+	}), nil
+}
+
+func identity(p geo.Point) geo.Point {
+	return p
+}
@@ -0,0 +1,114 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestLookup(t *testing.T) {
+	for _, srid := range []int{4326, 3857, 3395, 32601, 32633, 32760, 25832, 2154, 27700, 3034, 3035, 5070, 3577} {
+		if _, ok := Lookup(srid); !ok {
+			t.Errorf("should be registered: %v", srid)
+		}
+	}
+
+	for _, srid := range []int{0, 32600, 32661, 1234} {
+		if _, ok := Lookup(srid); ok {
+			t.Errorf("should not be registered: %v", srid)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	sf := geo.Point{-122.416667, 37.783333}
+
+	g, err := Transform(sf, 4326, 3857)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g != WGS84.ToMercator(sf) {
+		t.Errorf("incorrect mercator: %v", g)
+	}
+
+	g, err = Transform(g, 3857, 32610)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := UTM(10, false).FromWGS84(sf)
+	if p := g.(geo.Point); math.Abs(p[0]-expected[0]) > 1e-6 || math.Abs(p[1]-expected[1]) > 1e-6 {
+		t.Errorf("incorrect utm: %v != %v", p, expected)
+	}
+
+	// ETRS89-LAEA example from EPSG guidance note 7-2
+	g, err = Transform(geo.LineString{{5, 50}}, 4326, 3035)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if p := g.(geo.LineString)[0]; math.Abs(p[0]-3962799.45) > 0.01 || math.Abs(p[1]-2999718.85) > 0.01 {
+		t.Errorf("incorrect laea: %v", p)
+	}
+
+	// the OSGB36 datum is shifted by around a hundred meters in Great Britain
+	bng := TransverseMercator{
+		Ellipsoid:        DatumOSGB36.Ellipsoid,
+		CentralMeridian:  -2,
+		LatitudeOfOrigin: 49,
+		ScaleFactor:      0.9996012717,
+		FalseEasting:     400000,
+		FalseNorthing:    -100000,
+	}
+
+	caister := geo.Point{1 + 43/60.0 + 4.5177/3600, 52 + 39/60.0 + 27.2531/3600}
+	g, err = Transform(caister, 4326, 27700)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p, unshifted := g.(geo.Point), bng.FromWGS84(caister)
+	if d := math.Hypot(p[0]-unshifted[0], p[1]-unshifted[1]); d < 50 || d > 200 {
+		t.Errorf("incorrect datum shift: %v != %v", p, unshifted)
+	}
+
+	g, err = Transform(g, 27700, 4326)
+	if p := g.(geo.Point); err != nil || math.Abs(p[0]-caister[0]) > 1e-7 || math.Abs(p[1]-caister[1]) > 1e-7 {
+		t.Errorf("incorrect inverse: %v %v", p, err)
+	}
+
+	g, err = Transform(geo.Point{1, 2}, 2154, 2154)
+	if err != nil || g != (geo.Point{1, 2}) {
+		t.Errorf("should not change: %v %v", g, err)
+	}
+}
+
+func TestTransform_unknown(t *testing.T) {
+	if _, err := Transform(geo.Point{}, 4326, 1234); err != ErrUnknownSRID {
+		t.Errorf("incorrect error: %v", err)
+	}
+
+	if _, err := Transform(geo.Point{}, 1234, 4326); err != ErrUnknownSRID {
+		t.Errorf("incorrect error: %v", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	double := Pair{
+		FromWGS84: func(p geo.Point) geo.Point { return geo.Point{2 * p[0], 2 * p[1]} },
+		ToWGS84:   func(p geo.Point) geo.Point { return geo.Point{p[0] / 2, p[1] / 2} },
+	}
+	Register(-1, double)
+	defer func() {
+		registryMu.Lock()
+		delete(registry, -1)
+		registryMu.Unlock()
+	}()
+
+	g, err := Transform(geo.Point{1, 2}, -1, 4326)
+	if err != nil || g != (geo.Point{0.5, 1}) {
+		t.Errorf("incorrect result: %v %v", g, err)
+	}
+}
@@ -36,3 +36,14 @@ func ExamplePolygon() {
 	// Output:
 	// [-122.41574403384001 37.77909471899779]
 }
+
+func ExampleUTM() {
+	sf := geo.Point{-122.416667, 37.783333}
+	zone, south := project.UTMZone(sf)
+	utm := project.UTM(zone, south)
+
+	p := project.Geometry(sf, utm.FromWGS84).(geo.Point)
+	fmt.Printf("%d %v %0.2f\n", zone, south, p)
+	// Output:
+	// 10 false [551365.62 4181936.01]
+}
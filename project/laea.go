@@ -0,0 +1,98 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// LambertAzimuthalEqualArea is a Lambert Azimuthal Equal Area projection on an ellipsoid,
+// in the oblique or, with a latitude of origin of 90 or -90, the polar aspect,
+// as in EPSG guidance note 7-2. The whole earth but the antipode of the origin is projected.
+// FromWGS84 and ToWGS84 are the geo.Projection pair for use with project.Geometry.
+type LambertAzimuthalEqualArea struct {
+	Ellipsoid        geodesic.Ellipsoid
+	CentralMeridian  float64 // the longitude of the origin in degrees
+	LatitudeOfOrigin float64 // in degrees
+	FalseEasting     float64 // in meters
+	FalseNorthing    float64 // in meters
+}
+
+// FromWGS84 projects the lon/lat point to easting and northing in meters.
+func (p LambertAzimuthalEqualArea) FromWGS84(g geo.Point) geo.Point {
+	e := math.Sqrt(p.Ellipsoid.E2())
+	a := p.Ellipsoid.A
+	qp := qsfn(math.Pi/2, e)
+	q := qsfn(deg2rad(g[1]), e)
+	lon := deg2rad(geo.WrapLon(g[0] - p.CentralMeridian))
+
+	if pole := p.pole(); pole != 0 {
+		rho := a * math.Sqrt(math.Max(0, qp-pole*q))
+		return geo.Point{
+			p.FalseEasting + rho*math.Sin(lon),
+			p.FalseNorthing - pole*rho*math.Cos(lon),
+		}
+	}
+
+	rq, beta0, d := p.constants(e, qp)
+	beta := math.Asin(math.Max(-1, math.Min(1, q/qp)))
+	b := rq * math.Sqrt(2/(1+math.Sin(beta0)*math.Sin(beta)+math.Cos(beta0)*math.Cos(beta)*math.Cos(lon)))
+	return geo.Point{
+		p.FalseEasting + b*d*math.Cos(beta)*math.Sin(lon),
+		p.FalseNorthing + b/d*(math.Cos(beta0)*math.Sin(beta)-math.Sin(beta0)*math.Cos(beta)*math.Cos(lon)),
+	}
+}
+
+// ToWGS84 returns the lon/lat of the point with easting and northing in meters.
+func (p LambertAzimuthalEqualArea) ToWGS84(g geo.Point) geo.Point {
+	e := math.Sqrt(p.Ellipsoid.E2())
+	a := p.Ellipsoid.A
+	qp := qsfn(math.Pi/2, e)
+	x, y := g[0]-p.FalseEasting, g[1]-p.FalseNorthing
+
+	if pole := p.pole(); pole != 0 {
+		rho := math.Hypot(x, y)
+		q := pole * (qp - rho*rho/(a*a))
+		return geo.Point{
+			geo.WrapLon(rad2deg(math.Atan2(x, -pole*y)) + p.CentralMeridian),
+			rad2deg(latitudeFromQ(q, e)),
+		}
+	}
+
+	rq, beta0, d := p.constants(e, qp)
+	rho := math.Hypot(x/d, d*y)
+	if rho == 0 {
+		return geo.Point{p.CentralMeridian, p.LatitudeOfOrigin}
+	}
+
+	c := 2 * math.Asin(math.Min(1, rho/(2*rq)))
+	beta := math.Asin(math.Cos(c)*math.Sin(beta0) + d*y*math.Sin(c)*math.Cos(beta0)/rho)
+	lon := math.Atan2(x*math.Sin(c), d*rho*math.Cos(beta0)*math.Cos(c)-d*d*y*math.Sin(beta0)*math.Sin(c))
+	return geo.Point{
+		geo.WrapLon(rad2deg(lon) + p.CentralMeridian),
+		rad2deg(latitudeFromQ(qp*math.Sin(beta), e)),
+	}
+}
+
+// pole returns 1 for the north polar aspect, -1 for the south and 0 for the oblique.
+func (p LambertAzimuthalEqualArea) pole() float64 {
+	switch p.LatitudeOfOrigin {
+	case 90:
+		return 1
+	case -90:
+		return -1
+	}
+
+	return 0
+}
+
+// constants returns the radius of the authalic sphere, the authalic latitude of the origin
+// and D, the ratio that makes the projection conformal at the origin, of the oblique aspect.
+func (p LambertAzimuthalEqualArea) constants(e, qp float64) (rq, beta0, d float64) {
+	phi0 := deg2rad(p.LatitudeOfOrigin)
+	rq = p.Ellipsoid.A * math.Sqrt(qp/2)
+	beta0 = math.Asin(qsfn(phi0, e) / qp)
+	d = p.Ellipsoid.A * msfn(phi0, e) / (rq * math.Cos(beta0))
+	return rq, beta0, d
+}
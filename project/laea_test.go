@@ -0,0 +1,74 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+func TestLambertAzimuthalEqualArea(t *testing.T) {
+	// ETRS89-LAEA Europe from EPSG guidance note 7-2
+	p := LambertAzimuthalEqualArea{
+		Ellipsoid:        geodesic.GRS80,
+		CentralMeridian:  10,
+		LatitudeOfOrigin: 52,
+		FalseEasting:     4321000,
+		FalseNorthing:    3210000,
+	}
+
+	g := geo.Point{5, 50}
+	expected := geo.Point{3962799.45, 2999718.85}
+
+	v := p.FromWGS84(g)
+	if math.Abs(v[0]-expected[0]) > 0.01 || math.Abs(v[1]-expected[1]) > 0.01 {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	v = p.ToWGS84(expected)
+	if math.Abs(v[0]-g[0]) > 1e-7 || math.Abs(v[1]-g[1]) > 1e-7 {
+		t.Errorf("incorrect inverse: %v != %v", v, g)
+	}
+
+	if v := p.ToWGS84(geo.Point{4321000, 3210000}); v != (geo.Point{10, 52}) {
+		t.Errorf("incorrect origin: %v", v)
+	}
+}
+
+func TestLambertAzimuthalEqualArea_polar(t *testing.T) {
+	for _, lat0 := range []float64{90, -90} {
+		p := LambertAzimuthalEqualArea{
+			Ellipsoid:        geodesic.WGS84,
+			LatitudeOfOrigin: lat0,
+		}
+
+		for _, g := range []geo.Point{{0, lat0 / 2}, {45, lat0 * 0.9}, {-135, lat0 * 0.7}, {170, -lat0 / 3}} {
+			v := p.ToWGS84(p.FromWGS84(g))
+			if math.Abs(v[0]-g[0]) > 1e-9 || math.Abs(v[1]-g[1]) > 1e-9 {
+				t.Errorf("incorrect round trip for %v: %v != %v", lat0, v, g)
+			}
+		}
+
+		// the meridian at 0 goes down from the north pole, up from the south
+		v := p.FromWGS84(geo.Point{0, lat0 / 2})
+		if math.Abs(v[0]) > 1e-6 || (v[1] < 0) != (lat0 > 0) {
+			t.Errorf("incorrect direction for %v: %v", lat0, v)
+		}
+	}
+}
+
+func TestLambertAzimuthalEqualArea_roundTrip(t *testing.T) {
+	p := LambertAzimuthalEqualArea{
+		Ellipsoid:        geodesic.WGS84,
+		CentralMeridian:  -100,
+		LatitudeOfOrigin: 45,
+	}
+
+	for _, g := range []geo.Point{{-100, 45}, {-80, 30}, {-150, 70}, {0, 0}, {-100, -40}} {
+		v := p.ToWGS84(p.FromWGS84(g))
+		if math.Abs(v[0]-g[0]) > 1e-9 || math.Abs(v[1]-g[1]) > 1e-9 {
+			t.Errorf("incorrect round trip: %v != %v", v, g)
+		}
+	}
+}
@@ -5,10 +5,14 @@ import (
 	"math"
 
 	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
 )
 
 const earthRadiusPi = geo.EarthRadius * math.Pi
 
+// worldMercator is the ellipsoidal Mercator projection of EPSG:3395.
+var worldMercator = EllipsoidalMercator{Ellipsoid: geodesic.WGS84, ScaleFactor: 1}
+
 var (
 	// Mercator performs the Spherical Pseudo-Mercator projection used by most web maps.
 	Mercator = struct {
@@ -21,11 +25,21 @@ var (
 			}
 		},
 	}
+	// WorldMercator performs the inverse of the ellipsoidal World Mercator projection, EPSG:3395,
+	// used by some marine charts.
+	WorldMercator = struct {
+		ToWGS84 geo.Projection
+	}{
+		ToWGS84: worldMercator.ToWGS84,
+	}
 	// WGS84 is what common uses lon/lat projection.
 	WGS84 = struct {
 		// ToMercator projections from WGS to Mercator, used by most web maps
 		ToMercator geo.Projection
+		// ToWorldMercator projections from WGS to the ellipsoidal World Mercator, EPSG:3395
+		ToWorldMercator geo.Projection
 	}{
+		ToWorldMercator: worldMercator.FromWGS84,
 		ToMercator: func(g geo.Point) geo.Point {
 			y := math.Log(math.Tan((90.0+g[1])*math.Pi/360.0)) * geo.EarthRadius
 			return geo.Point{
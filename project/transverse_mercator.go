@@ -0,0 +1,181 @@
+package project
+
+import (
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// TransverseMercator is a Transverse Mercator projection on an ellipsoid.
+// It uses the Krüger series to the sixth order in the third flattening, as in
+// Karney (2011) "Transverse Mercator with an accuracy of a few nanometers",
+// which is accurate to better than a millimeter within 3900 km of the central meridian.
+// FromWGS84 and ToWGS84 are the geo.Projection pair for use with project.Geometry.
+// Create it with NewTransverseMercator to compute the series once and not for every point.
+type TransverseMercator struct {
+	Ellipsoid        geodesic.Ellipsoid
+	CentralMeridian  float64 // the longitude of the origin in degrees
+	LatitudeOfOrigin float64 // in degrees
+	ScaleFactor      float64 // on the central meridian
+	FalseEasting     float64 // in meters
+	FalseNorthing    float64 // in meters
+
+	kruger krugerSeries
+}
+
+// NewTransverseMercator creates the projection with the Krüger series of the
+// ellipsoid and the latitude of origin in degrees.
+func NewTransverseMercator(e geodesic.Ellipsoid, centralMeridian, latitudeOfOrigin, scaleFactor, falseEasting, falseNorthing float64) TransverseMercator {
+	tm := TransverseMercator{
+		Ellipsoid:        e,
+		CentralMeridian:  centralMeridian,
+		LatitudeOfOrigin: latitudeOfOrigin,
+		ScaleFactor:      scaleFactor,
+		FalseEasting:     falseEasting,
+		FalseNorthing:    falseNorthing,
+	}
+	tm.kruger = tm.series()
+	return tm
+}
+
+// FromWGS84 projects the lon/lat point to easting and northing in meters.
+func (tm TransverseMercator) FromWGS84(p geo.Point) geo.Point {
+	s := tm.series()
+	lon := deg2rad(geo.WrapLon(p[0] - tm.CentralMeridian))
+	xi, eta := s.forward(deg2rad(p[1]), lon)
+	k := tm.ScaleFactor * s.a
+	return geo.Point{
+		tm.FalseEasting + k*eta,
+		tm.FalseNorthing + k*(xi-s.xi0),
+	}
+}
+
+// ToWGS84 returns the lon/lat of the point with easting and northing in meters.
+func (tm TransverseMercator) ToWGS84(p geo.Point) geo.Point {
+	s := tm.series()
+	k := tm.ScaleFactor * s.a
+	lat, lon := s.inverse((p[1]-tm.FalseNorthing)/k+s.xi0, (p[0]-tm.FalseEasting)/k)
+	return geo.Point{
+		geo.WrapLon(rad2deg(lon) + tm.CentralMeridian),
+		rad2deg(lat),
+	}
+}
+
+// series returns the Krüger series of the projection, the ones computed by
+// NewTransverseMercator if the ellipsoid and the latitude of origin did not change since.
+func (tm TransverseMercator) series() krugerSeries {
+	if s := tm.kruger; s.a != 0 && s.ellipsoid == tm.Ellipsoid && s.latitudeOfOrigin == tm.LatitudeOfOrigin {
+		return s
+	}
+
+	s := newKrugerSeries(tm.Ellipsoid)
+	s.latitudeOfOrigin = tm.LatitudeOfOrigin
+	if tm.LatitudeOfOrigin != 0 {
+		s.xi0, _ = s.forward(deg2rad(tm.LatitudeOfOrigin), 0)
+	}
+
+	return s
+}
+
+// krugerSeries holds the coefficients of the Krüger series for an ellipsoid.
+type krugerSeries struct {
+	ellipsoid        geodesic.Ellipsoid
+	latitudeOfOrigin float64 // in degrees, of xi0
+
+	a     float64 // the rectifying radius
+	e     float64 // the eccentricity
+	xi0   float64 // the northing of the latitude of origin on the unit rectifying sphere
+	alpha [6]float64
+	beta  [6]float64
+}
+
+func newKrugerSeries(e geodesic.Ellipsoid) krugerSeries {
+	n := e.F / (2 - e.F)
+	n2 := n * n
+	n3 := n2 * n
+	n4 := n3 * n
+	n5 := n4 * n
+	n6 := n5 * n
+
+	return krugerSeries{
+		ellipsoid: e,
+		a:         e.A / (1 + n) * (1 + n2/4 + n4/64 + n6/256),
+		e:         math.Sqrt(e.E2()),
+		alpha: [6]float64{
+			n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+			13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+			61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+			49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+			34729*n5/80640 - 3418889*n6/1995840,
+			212378941 * n6 / 319334400,
+		},
+		beta: [6]float64{
+			n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+			n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+			17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+			4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+			4583*n5/161280 - 108847*n6/3991680,
+			20648693 * n6 / 638668800,
+		},
+	}
+}
+
+// forward returns the coordinates on the unit rectifying sphere, northing and easting,
+// for the latitude and the longitude from the central meridian in radians.
+func (s krugerSeries) forward(lat, lon float64) (xi, eta float64) {
+	// the conformal latitude
+	tau := math.Tan(lat)
+	sigma := math.Sinh(s.e * math.Atanh(s.e*tau/math.Sqrt(1+tau*tau)))
+	tauP := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+	if math.Abs(lat) == math.Pi/2 {
+		tauP = math.Copysign(math.Inf(1), lat)
+	}
+
+	xiP := math.Atan2(tauP, math.Cos(lon))
+	etaP := math.Asinh(math.Sin(lon) / math.Sqrt(tauP*tauP+math.Cos(lon)*math.Cos(lon)))
+	if math.IsInf(tauP, 0) {
+		xiP, etaP = math.Copysign(math.Pi/2, lat), 0
+	}
+
+	xi, eta = xiP, etaP
+	for j, a := range s.alpha {
+		k := float64(2 * (j + 1))
+		xi += a * math.Sin(k*xiP) * math.Cosh(k*etaP)
+		eta += a * math.Cos(k*xiP) * math.Sinh(k*etaP)
+	}
+
+	return xi, eta
+}
+
+// inverse returns the latitude and the longitude from the central meridian
+// in radians for the coordinates on the unit rectifying sphere.
+func (s krugerSeries) inverse(xi, eta float64) (lat, lon float64) {
+	xiP, etaP := xi, eta
+	for j, b := range s.beta {
+		k := float64(2 * (j + 1))
+		xiP -= b * math.Sin(k*xi) * math.Cosh(k*eta)
+		etaP -= b * math.Cos(k*xi) * math.Sinh(k*eta)
+	}
+
+	sinhEta := math.Sinh(etaP)
+	cosXi := math.Cos(xiP)
+	tauP := math.Sin(xiP) / math.Sqrt(sinhEta*sinhEta+cosXi*cosXi)
+	lon = math.Atan2(sinhEta, cosXi)
+
+	// Newton's method for the latitude from the conformal latitude
+	e2 := s.e * s.e
+	tau := tauP
+	for i := 0; i < 10; i++ {
+		sigma := math.Sinh(s.e * math.Atanh(s.e*tau/math.Sqrt(1+tau*tau)))
+		ti := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+		d := (tauP - ti) / math.Sqrt(1+ti*ti) *
+			(1 + (1-e2)*tau*tau) / ((1 - e2) * math.Sqrt(1+tau*tau))
+		tau += d
+		if math.Abs(d) < 1e-14 {
+			break
+		}
+	}
+
+	return math.Atan(tau), lon
+}
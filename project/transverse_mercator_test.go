@@ -0,0 +1,99 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+func TestTransverseMercator(t *testing.T) {
+	// the British National Grid example from EPSG guidance note 7-2
+	bng := TransverseMercator{
+		Ellipsoid:        geodesic.NewEllipsoid(6377563.396, 299.3249646),
+		CentralMeridian:  -2,
+		LatitudeOfOrigin: 49,
+		ScaleFactor:      0.9996012717,
+		FalseEasting:     400000,
+		FalseNorthing:    -100000,
+	}
+
+	p := geo.Point{0.5, 50.5}
+	expected := geo.Point{577274.99, 69740.50}
+
+	v := bng.FromWGS84(p)
+	if math.Abs(v[0]-expected[0]) > 0.01 || math.Abs(v[1]-expected[1]) > 0.01 {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	v = bng.ToWGS84(expected)
+	if math.Abs(v[0]-p[0]) > 1e-7 || math.Abs(v[1]-p[1]) > 1e-7 {
+		t.Errorf("incorrect inverse: %v != %v", v, p)
+	}
+}
+
+func TestTransverseMercator_roundTrip(t *testing.T) {
+	tm := UTM(31, false)
+	for _, p := range []geo.Point{
+		{3, 0}, {0, 0}, {-10, 45}, {20, -60}, {3, 89.9}, {5, -89}, {40, 10}, {-177, 1},
+	} {
+		v := tm.ToWGS84(tm.FromWGS84(p))
+		if math.Abs(v[0]-p[0]) > 1e-9 || math.Abs(v[1]-p[1]) > 1e-9 {
+			t.Errorf("incorrect round trip: %v != %v", v, p)
+		}
+	}
+}
+
+func TestTransverseMercator_pole(t *testing.T) {
+	tm := UTM(31, false)
+	v := tm.FromWGS84(geo.Point{3, 90})
+	if math.Abs(v[0]-500000) > 1e-6 || math.Abs(v[1]-9997964.943) > 0.001 {
+		t.Errorf("incorrect north pole: %v", v)
+	}
+
+	if p := tm.ToWGS84(v); math.Abs(p[1]-90) > 1e-9 {
+		t.Errorf("incorrect inverse: %v", p)
+	}
+}
+
+func TestTransverseMercator_series(t *testing.T) {
+	bng := TransverseMercator{
+		Ellipsoid:        geodesic.NewEllipsoid(6377563.396, 299.3249646),
+		CentralMeridian:  -2,
+		LatitudeOfOrigin: 49,
+		ScaleFactor:      0.9996012717,
+		FalseEasting:     400000,
+		FalseNorthing:    -100000,
+	}
+
+	p := geo.Point{0.5, 50.5}
+	expected := bng.FromWGS84(p)
+
+	// the series computed once give the same result
+	v := NewTransverseMercator(bng.Ellipsoid, -2, 49, 0.9996012717, 400000, -100000).FromWGS84(p)
+	if v != expected {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+
+	// the series are not used after the ellipsoid or origin changed
+	tm := UTM(31, false)
+	tm.Ellipsoid, tm.LatitudeOfOrigin = bng.Ellipsoid, bng.LatitudeOfOrigin
+	tm.CentralMeridian, tm.ScaleFactor = bng.CentralMeridian, bng.ScaleFactor
+	tm.FalseEasting, tm.FalseNorthing = bng.FalseEasting, bng.FalseNorthing
+	if v := tm.FromWGS84(p); v != expected {
+		t.Errorf("incorrect projection: %v != %v", v, expected)
+	}
+}
+
+func BenchmarkTransverseMercator(b *testing.B) {
+	// two zones, like a transform from one to the other
+	tm1, tm2 := UTM(33, false), UTM(34, false)
+	p := geo.Point{15.5, 52.3}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tm2.FromWGS84(tm1.ToWGS84(tm1.FromWGS84(p)))
+	}
+}
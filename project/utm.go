@@ -0,0 +1,98 @@
+package project
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geodesic"
+)
+
+// utmBands are the latitude band letters of 8 degrees from 80 S, X is 12 degrees up to 84 N.
+const utmBands = "CDEFGHJKLMNPQRSTUVWX"
+
+// UTM returns the Universal Transverse Mercator projection for the zone,
+// from 1 to 60, on the WGS84 ellipsoid. The northing of the southern
+// hemisphere has a false northing of 10000 km.
+func UTM(zone int, south bool) TransverseMercator {
+	return utm(geodesic.WGS84, zone, south)
+}
+
+// utm returns the UTM projection for the zone on the ellipsoid.
+func utm(e geodesic.Ellipsoid, zone int, south bool) TransverseMercator {
+	if zone < 1 || zone > 60 {
+		panic(fmt.Sprintf("utm zone must be from 1 to 60: %v", zone))
+	}
+
+	var falseNorthing float64
+	if south {
+		falseNorthing = 10000000
+	}
+
+	return NewTransverseMercator(e, float64(6*zone-183), 0, 0.9996, 500000, falseNorthing)
+}
+
+// UTMAt returns the UTM projection for the zone of the lon/lat point.
+func UTMAt(p geo.Point) TransverseMercator {
+	return UTM(UTMZone(p))
+}
+
+// UTMZone returns the UTM zone of the lon/lat point and if it is in the southern hemisphere.
+// It includes the exceptions for southwest Norway and Svalbard.
+func UTMZone(p geo.Point) (zone int, south bool) {
+	lon, lat := geo.WrapLon(p[0]), p[1]
+	zone = int(math.Floor((lon+180)/6)) + 1
+
+	switch {
+	case lat >= 56 && lat < 64 && lon >= 3 && lon < 12:
+		zone = 32
+	case lat >= 72 && lat <= 84 && lon >= 0 && lon < 42:
+		switch {
+		case lon < 9:
+			zone = 31
+		case lon < 21:
+			zone = 33
+		case lon < 33:
+			zone = 35
+		default:
+			zone = 37
+		}
+	}
+
+	return zone, lat < 0
+}
+
+// UTMBand returns the latitude band letter of the latitude,
+// from C at 80 S to X up to 84 N, or 0 outside of that range.
+func UTMBand(lat float64) byte {
+	if lat < -80 || lat > 84 {
+		return 0
+	}
+
+	i := int(math.Floor((lat + 80) / 8))
+	if i > len(utmBands)-1 {
+		i = len(utmBands) - 1
+	}
+
+	return utmBands[i]
+}
+
+// ParseUTMZone parses a zone with its latitude band letter, e.g. "33U" or "18 t".
+// Bands C to M are in the southern hemisphere, N to X in the northern.
+func ParseUTMZone(s string) (zone int, south bool, err error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) < 2 {
+		return 0, false, fmt.Errorf("project: invalid utm zone: %q", s)
+	}
+
+	band := s[len(s)-1]
+	i := strings.IndexByte(utmBands, band)
+	zone, err = strconv.Atoi(strings.TrimSpace(s[:len(s)-1]))
+	if err != nil || i < 0 || zone < 1 || zone > 60 {
+		return 0, false, fmt.Errorf("project: invalid utm zone: %q", s)
+	}
+
+	return zone, band < 'N', nil
+}
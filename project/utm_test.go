@@ -0,0 +1,141 @@
+package project
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+)
+
+func TestUTM(t *testing.T) {
+	cases := []struct {
+		name   string
+		zone   int
+		south  bool
+		point  geo.Point
+		result geo.Point
+	}{
+		{
+			name:   "equator at 3 degrees from the central meridian",
+			zone:   31,
+			point:  geo.Point{0, 0},
+			result: geo.Point{166021.443, 0},
+		},
+		{
+			name:   "central meridian",
+			zone:   33,
+			point:  geo.Point{15, 0},
+			result: geo.Point{500000, 0},
+		},
+		{
+			name:   "southern hemisphere",
+			zone:   56,
+			south:  true,
+			point:  geo.Point{153, 0},
+			result: geo.Point{500000, 10000000},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := UTM(tc.zone, tc.south).FromWGS84(tc.point)
+			if math.Abs(v[0]-tc.result[0]) > 0.001 || math.Abs(v[1]-tc.result[1]) > 0.001 {
+				t.Errorf("incorrect projection: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestUTM_invalidZone(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("should panic")
+		}
+	}()
+
+	UTM(61, false)
+}
+
+func TestUTMZone(t *testing.T) {
+	cases := []struct {
+		name  string
+		point geo.Point
+		zone  int
+		south bool
+	}{
+		{name: "greenwich", point: geo.Point{0, 51.5}, zone: 31},
+		{name: "west edge", point: geo.Point{-180, 0}, zone: 1},
+		{name: "east edge", point: geo.Point{179.9, 0}, zone: 60},
+		{name: "180", point: geo.Point{180, 0}, zone: 1},
+		{name: "sydney", point: geo.Point{151.2, -33.9}, zone: 56, south: true},
+		{name: "bergen", point: geo.Point{5.3, 60.4}, zone: 32},
+		{name: "svalbard", point: geo.Point{15.6, 78.2}, zone: 33},
+		{name: "svalbard east", point: geo.Point{34, 79}, zone: 37},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			zone, south := UTMZone(tc.point)
+			if zone != tc.zone || south != tc.south {
+				t.Errorf("incorrect zone: %v %v != %v %v", zone, south, tc.zone, tc.south)
+			}
+		})
+	}
+
+	p := geo.Point{151.2, -33.9}
+	if UTMAt(p) != UTM(56, true) {
+		t.Errorf("incorrect projection")
+	}
+}
+
+func TestUTMBand(t *testing.T) {
+	cases := []struct {
+		lat  float64
+		band byte
+	}{
+		{lat: -80, band: 'C'},
+		{lat: -0.1, band: 'M'},
+		{lat: 0, band: 'N'},
+		{lat: 51.5, band: 'U'},
+		{lat: 84, band: 'X'},
+		{lat: 85, band: 0},
+	}
+
+	for _, tc := range cases {
+		if b := UTMBand(tc.lat); b != tc.band {
+			t.Errorf("incorrect band for %v: %c != %c", tc.lat, b, tc.band)
+		}
+	}
+}
+
+func TestParseUTMZone(t *testing.T) {
+	cases := []struct {
+		input string
+		zone  int
+		south bool
+		err   bool
+	}{
+		{input: "33U", zone: 33},
+		{input: "18 t", zone: 18},
+		{input: "56H", zone: 56, south: true},
+		{input: "1C", zone: 1, south: true},
+		{input: "33S", zone: 33},
+		{input: "33", err: true},
+		{input: "61N", err: true},
+		{input: "33I", err: true},
+		{input: "U", err: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			zone, south, err := ParseUTMZone(tc.input)
+			if (err != nil) != tc.err {
+				t.Fatalf("incorrect error: %v", err)
+			}
+
+			if zone != tc.zone || south != tc.south {
+				t.Errorf("incorrect zone: %v %v", zone, south)
+			}
+		})
+	}
+}
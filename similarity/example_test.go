@@ -0,0 +1,33 @@
+package similarity_test
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+	"github.com/pchchv/geo/similarity"
+)
+
+func ExampleHausdorff() {
+	route := geo.LineString{{0, 0}, {10, 0}}
+	trace := geo.LineString{{5, 1}, {5, -1}}
+
+	fmt.Println(similarity.Hausdorff(route, trace, planar.Distance))
+
+	// the middle of the route is close to the trace
+	fmt.Println(similarity.Hausdorff(route, trace, planar.Distance, similarity.Densify(0.5)))
+	// Output:
+	// 5.0990195135927845
+	// 5
+}
+
+func ExampleFrechet() {
+	route := geo.LineString{{0, 0}, {1, 1}, {2, 0}}
+	reversed := geo.LineString{{2, 0}, {1, 1}, {0, 0}}
+
+	fmt.Println(similarity.Hausdorff(route, reversed, planar.Distance))
+	fmt.Println(similarity.Frechet(route, reversed, planar.Distance))
+	// Output:
+	// 0
+	// 2
+}
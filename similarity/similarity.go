@@ -0,0 +1,176 @@
+// Package similarity measures how similar two geometries are, e.g. to compare
+// GPS traces with a route, using any geo.DistanceFunc such as planar.Distance
+// or geometries.DistanceHaversine.
+package similarity
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+)
+
+// Option is a possible parameter to Hausdorff and Frechet.
+type Option func(*options)
+
+type options struct {
+	densify float64
+}
+
+// Densify splits every segment into equal parts of at most the fraction of its length
+// before comparing, so the distances between the segments are measured and not only
+// between the vertices. The new points are interpolated linearly in the coordinates.
+// The fraction must be in (0, 1].
+func Densify(fraction float64) Option {
+	if !(fraction > 0 && fraction <= 1) {
+		panic(fmt.Sprintf("densify fraction must be in (0, 1]: %v", fraction))
+	}
+
+	return func(o *options) {
+		o.densify = fraction
+	}
+}
+
+// Hausdorff returns the discrete Hausdorff distance between the geometries,
+// the largest distance from a vertex of one geometry to the closest vertex of the other.
+// It is 0 if both geometries are empty and +Inf if only one is.
+func Hausdorff(a, b geo.Geometry, df geo.DistanceFunc, opts ...Option) float64 {
+	pa, pb := points(a, opts), points(b, opts)
+	if len(pa) == 0 || len(pb) == 0 {
+		return emptyDistance(pa, pb)
+	}
+
+	return math.Max(directedHausdorff(pa, pb, df), directedHausdorff(pb, pa, df))
+}
+
+// Frechet returns the discrete Fréchet distance between the geometries, the shortest
+// leash that connects two walkers that move forward along the vertices of each geometry.
+// Unlike Hausdorff it takes the direction and order of the points into account.
+// The vertices of multi geometries and polygons are walked in order, part after part.
+// It is 0 if both geometries are empty and +Inf if only one is.
+func Frechet(a, b geo.Geometry, df geo.DistanceFunc, opts ...Option) float64 {
+	pa, pb := points(a, opts), points(b, opts)
+	if len(pa) == 0 || len(pb) == 0 {
+		return emptyDistance(pa, pb)
+	}
+
+	// ca[j] is the coupling distance of the walk to pa[i-1] and pb[j]
+	ca := make([]float64, len(pb))
+	cb := make([]float64, len(pb))
+	for i, p := range pa {
+		for j, q := range pb {
+			d := df(p, q)
+			switch {
+			case i == 0 && j == 0:
+				cb[j] = d
+			case i == 0:
+				cb[j] = math.Max(cb[j-1], d)
+			case j == 0:
+				cb[j] = math.Max(ca[j], d)
+			default:
+				cb[j] = math.Max(math.Min(math.Min(ca[j], ca[j-1]), cb[j-1]), d)
+			}
+		}
+
+		ca, cb = cb, ca
+	}
+
+	return ca[len(pb)-1]
+}
+
+// directedHausdorff returns the largest distance from a point of pa to the closest point of pb.
+func directedHausdorff(pa, pb []geo.Point, df geo.DistanceFunc) (dist float64) {
+	for _, p := range pa {
+		closest := math.Inf(1)
+		for _, q := range pb {
+			if d := df(p, q); d < closest {
+				closest = d
+				if closest <= dist {
+					// the point can not increase the distance
+					break
+				}
+			}
+		}
+
+		dist = math.Max(dist, closest)
+	}
+
+	return dist
+}
+
+func emptyDistance(pa, pb []geo.Point) float64 {
+	if len(pa) == 0 && len(pb) == 0 {
+		return 0
+	}
+
+	return math.Inf(1)
+}
+
+// points returns the vertices of the geometry in order, densified if set.
+func points(g geo.Geometry, opts []Option) []geo.Point {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	var result []geo.Point
+	line := func(ls []geo.Point) {
+		if o.densify == 0 || len(ls) < 2 {
+			result = append(result, ls...)
+			return
+		}
+
+		// the tolerance keeps e.g. 1/3 at 3 parts
+		n := int(math.Ceil(1/o.densify - 1e-9))
+		result = append(result, ls[0])
+		for i := 1; i < len(ls); i++ {
+			a, b := ls[i-1], ls[i]
+			for k := 1; k < n; k++ {
+				f := float64(k) / float64(n)
+				result = append(result, geo.Point{a[0] + f*(b[0]-a[0]), a[1] + f*(b[1]-a[1])})
+			}
+
+			result = append(result, b)
+		}
+	}
+
+	var add func(g geo.Geometry)
+	add = func(g geo.Geometry) {
+		switch g := g.(type) {
+		case nil:
+		case geo.Point:
+			result = append(result, g)
+		case geo.MultiPoint:
+			result = append(result, g...)
+		case geo.LineString:
+			line(g)
+		case geo.MultiLineString:
+			for _, ls := range g {
+				line(ls)
+			}
+		case geo.Ring:
+			line(g)
+		case geo.Polygon:
+			for _, r := range g {
+				line(r)
+			}
+		case geo.MultiPolygon:
+			for _, p := range g {
+				add(p)
+			}
+		case geo.Collection:
+			for _, c := range g {
+				add(c)
+			}
+		case geo.Bound:
+			if !g.IsEmpty() {
+				line(g.ToRing())
+			}
+		default:
+			panic(fmt.Sprintf("geometry type not supported: %T", g))
+		}
+	}
+
+	add(g)
+	return result
+}
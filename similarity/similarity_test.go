@@ -0,0 +1,178 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/geometries"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestHausdorff(t *testing.T) {
+	cases := []struct {
+		name   string
+		a, b   geo.Geometry
+		opts   []Option
+		result float64
+	}{
+		{
+			name:   "same line",
+			a:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			result: 0,
+		},
+		{
+			name:   "reversed line",
+			a:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:      geo.LineString{{2, 0}, {1, 1}, {0, 0}},
+			result: 0,
+		},
+		{
+			name:   "not symmetric",
+			a:      geo.LineString{{0, 0}, {10, 0}},
+			b:      geo.LineString{{0, 1}, {5, 1}},
+			result: math.Sqrt(26),
+		},
+		{
+			name:   "vertices only",
+			a:      geo.LineString{{0, 0}, {10, 0}},
+			b:      geo.LineString{{5, 1}, {5, -1}},
+			result: math.Sqrt(26),
+		},
+		{
+			name:   "densified",
+			a:      geo.LineString{{0, 0}, {10, 0}},
+			b:      geo.LineString{{5, 1}, {5, -1}},
+			opts:   []Option{Densify(0.5)},
+			result: 5,
+		},
+		{
+			name:   "polygon and points",
+			a:      geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+			b:      geo.MultiPoint{{0, 0}, {4, 4}},
+			result: 4,
+		},
+		{
+			name:   "both empty",
+			a:      geo.LineString{},
+			b:      geo.MultiPoint{},
+			result: 0,
+		},
+		{
+			name:   "one empty",
+			a:      geo.LineString{{0, 0}},
+			b:      geo.MultiPoint{},
+			result: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Hausdorff(tc.a, tc.b, planar.Distance, tc.opts...)
+			if math.Abs(d-tc.result) > 1e-12 && d != tc.result {
+				t.Errorf("incorrect distance: %v != %v", d, tc.result)
+			}
+		})
+	}
+}
+
+func TestFrechet(t *testing.T) {
+	cases := []struct {
+		name   string
+		a, b   geo.Geometry
+		opts   []Option
+		result float64
+	}{
+		{
+			name:   "same line",
+			a:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			result: 0,
+		},
+		{
+			name:   "reversed line",
+			a:      geo.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:      geo.LineString{{2, 0}, {1, 1}, {0, 0}},
+			result: 2,
+		},
+		{
+			name:   "parallel lines",
+			a:      geo.LineString{{0, 0}, {1, 0}, {2, 0}, {3, 0}},
+			b:      geo.LineString{{0, 1}, {1, 1}, {2, 1}, {3, 1}},
+			result: 1,
+		},
+		{
+			name:   "different number of points",
+			a:      geo.LineString{{0, 0}, {3, 0}},
+			b:      geo.LineString{{0, 1}, {1, 1}, {2, 1}, {3, 1}},
+			result: math.Sqrt2,
+		},
+		{
+			name: "densified",
+			a:    geo.LineString{{0, 0}, {3, 0}},
+			b:    geo.LineString{{0, 1}, {1, 1}, {2, 1}, {3, 1}},
+			opts: []Option{Densify(1.0 / 3)},
+			// the points of b are a third apart
+			result: math.Sqrt(10) / 3,
+		},
+		{
+			name:   "one empty",
+			a:      geo.LineString{{0, 0}},
+			b:      geo.LineString{},
+			result: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Frechet(tc.a, tc.b, planar.Distance, tc.opts...)
+			if math.Abs(d-tc.result) > 1e-12 && d != tc.result {
+				t.Errorf("incorrect distance: %v != %v", d, tc.result)
+			}
+		})
+	}
+}
+
+func TestFrechet_atLeastHausdorff(t *testing.T) {
+	a := geo.LineString{{0, 0}, {2, 3}, {5, 1}, {7, 4}, {9, 0}}
+	b := geo.LineString{{0, 1}, {3, 2}, {4, 0}, {8, 3}, {9, 1}, {10, 0}}
+	if h, f := Hausdorff(a, b, planar.Distance), Frechet(a, b, planar.Distance); f < h {
+		t.Errorf("frechet smaller than hausdorff: %v < %v", f, h)
+	}
+}
+
+func TestHausdorff_haversine(t *testing.T) {
+	a := geo.LineString{{0, 0}, {1, 0}}
+	b := geo.LineString{{0, 0}, {1, 0.01}}
+	expected := geometries.DistanceHaversine(geo.Point{1, 0}, geo.Point{1, 0.01})
+	if d := Hausdorff(a, b, geometries.DistanceHaversine); math.Abs(d-expected) > 1e-9 {
+		t.Errorf("incorrect distance: %v != %v", d, expected)
+	}
+}
+
+func TestDensify(t *testing.T) {
+	ps := points(geo.LineString{{0, 0}, {3, 0}}, []Option{Densify(1.0 / 3)})
+	if len(ps) != 4 || ps[1] != (geo.Point{1, 0}) {
+		t.Errorf("incorrect points: %v", ps)
+	}
+
+	for _, f := range []float64{0, -1, 1.5, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for %v", f)
+				}
+			}()
+
+			Densify(f)
+		}()
+	}
+}
+
+func TestSimilarity_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Hausdorff(g, g, planar.Distance, Densify(0.5))
+		Frechet(g, g, planar.Distance, Densify(0.5))
+	}
+}
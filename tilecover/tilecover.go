@@ -40,6 +40,29 @@ func Bound(b geo.Bound, z maptile.Zoom) maptile.Set {
 	return result
 }
 
+// BoundWrapped creates a tile cover for the bound that may cross the antimeridian,
+// i.e. all the tiles that intersect it on both sides.
+func BoundWrapped(b geo.BoundWrapped, z maptile.Zoom) maptile.Set {
+	if b.IsEmpty() {
+		return make(maptile.Set)
+	}
+
+	lo, hi := maptile.BoundRange(b, z)
+	n := uint32(1 << z)
+	result := make(maptile.Set)
+	for x := lo.X; ; x = (x + 1) % n {
+		for y := lo.Y; y <= hi.Y; y++ {
+			result[maptile.Tile{X: x, Y: y, Z: z}] = true
+		}
+
+		if x == hi.X {
+			break
+		}
+	}
+
+	return result
+}
+
 // Geometry returns the covering set of tiles for the given geometry.
 func Geometry(g geo.Geometry, z maptile.Zoom) (maptile.Set, error) {
 	switch g := g.(type) {
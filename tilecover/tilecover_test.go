@@ -1,9 +1,11 @@
 package tilecover
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/maptile"
 )
 
 func TestGeometry(t *testing.T) {
@@ -13,3 +15,25 @@ func TestGeometry(t *testing.T) {
 		}
 	}
 }
+
+func TestBoundWrapped(t *testing.T) {
+	b := geo.BoundWrapped{Min: geo.Point{170, -20}, Max: geo.Point{-170, -10}}
+	tiles := BoundWrapped(b, 2)
+	expected := maptile.Set{
+		maptile.New(3, 2, 2): true,
+		maptile.New(0, 2, 2): true,
+	}
+
+	if !reflect.DeepEqual(tiles, expected) {
+		t.Errorf("incorrect tiles: %v", tiles)
+	}
+
+	b = geo.BoundWrapped{Min: geo.Point{-100, 10}, Max: geo.Point{-80, 30}}
+	if tiles := BoundWrapped(b, 4); !reflect.DeepEqual(tiles, Bound(geo.Bound{Min: b.Min, Max: b.Max}, 4)) {
+		t.Errorf("should match the bound cover: %v", tiles)
+	}
+
+	if tiles := BoundWrapped(geo.BoundWrapped{Min: geo.Point{10, -20}, Max: geo.Point{5, -10}}, 2); len(tiles) != 4 {
+		t.Errorf("should go all the way around: %v", tiles)
+	}
+}
@@ -0,0 +1,561 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/quadtree"
+)
+
+// Delaunay is the Delaunay triangulation of a set of points,
+// computed using the sweep-hull algorithm of the Delaunator library,
+// https://github.com/mapbox/delaunator.
+type Delaunay struct {
+	Points geo.MultiPoint
+
+	// Triangles has three point indexes for each counter-clockwise triangle.
+	Triangles []int
+
+	// Halfedges has the index of the opposite halfedge for each halfedge,
+	// i.e. each index into Triangles, or -1 for edges on the hull.
+	Halfedges []int
+
+	// Hull has the point indexes of the counter-clockwise convex hull.
+	// If all the points are collinear there are no triangles
+	// and the hull is the points sorted along the line.
+	Hull []int
+}
+
+// NewDelaunay triangulates the points. Duplicate points are
+// only triangulated once, the others are not used.
+func NewDelaunay(points geo.MultiPoint) *Delaunay {
+	d := &Delaunay{Points: points}
+	if len(points) == 0 {
+		return d
+	}
+
+	// the algorithm works in screen coordinates with the y axis down,
+	// flipping it gives counter-clockwise triangles
+	coords := make([]float64, 0, 2*len(points))
+	for _, p := range points {
+		coords = append(coords, p[0], -p[1])
+	}
+
+	s := newSweep(coords)
+	s.run()
+
+	d.Triangles = s.triangles[:s.trianglesLen]
+	d.Halfedges = s.halfedges[:s.trianglesLen]
+	d.Hull = s.hull
+	return d
+}
+
+// NewDelaunayQuadtree triangulates the points of all the items in the quadtree.
+// The point indexes refer to the returned pointers.
+func NewDelaunayQuadtree(q *quadtree.Quadtree) (*Delaunay, []geo.Pointer) {
+	pointers := q.InBound(nil, q.Bound())
+	points := make(geo.MultiPoint, 0, len(pointers))
+	for _, p := range pointers {
+		points = append(points, p.Point())
+	}
+
+	return NewDelaunay(points), pointers
+}
+
+// Len returns the number of triangles.
+func (d *Delaunay) Len() int {
+	return len(d.Triangles) / 3
+}
+
+// Triangle returns the i-th triangle as a closed counter-clockwise ring.
+func (d *Delaunay) Triangle(i int) geo.Ring {
+	return geo.Ring{
+		d.Points[d.Triangles[3*i]],
+		d.Points[d.Triangles[3*i+1]],
+		d.Points[d.Triangles[3*i+2]],
+		d.Points[d.Triangles[3*i]],
+	}
+}
+
+// MultiPolygon returns the triangles as polygons.
+func (d *Delaunay) MultiPolygon() geo.MultiPolygon {
+	mp := make(geo.MultiPolygon, 0, d.Len())
+	for i := 0; i < d.Len(); i++ {
+		mp = append(mp, geo.Polygon{d.Triangle(i)})
+	}
+
+	return mp
+}
+
+// Edges returns the point indexes of the edges of the triangulation,
+// the lower index first, sorted. If all the points are collinear
+// the edges connect the consecutive points on the line.
+func (d *Delaunay) Edges() [][2]int {
+	var edges [][2]int
+	if len(d.Triangles) == 0 {
+		for i := 1; i < len(d.Hull); i++ {
+			edges = append(edges, orderedEdge(d.Hull[i-1], d.Hull[i]))
+		}
+	}
+
+	for e, o := range d.Halfedges {
+		// every edge once, inner edges have two halfedges
+		if e > o {
+			edges = append(edges, orderedEdge(d.Triangles[e], d.Triangles[nextHalfedge(e)]))
+		}
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i][0] != edges[j][0] {
+			return edges[i][0] < edges[j][0]
+		}
+
+		return edges[i][1] < edges[j][1]
+	})
+
+	return edges
+}
+
+// MultiLineString returns the edges as line strings.
+func (d *Delaunay) MultiLineString() geo.MultiLineString {
+	edges := d.Edges()
+	mls := make(geo.MultiLineString, 0, len(edges))
+	for _, e := range edges {
+		mls = append(mls, geo.LineString{d.Points[e[0]], d.Points[e[1]]})
+	}
+
+	return mls
+}
+
+func orderedEdge(a, b int) [2]int {
+	if a > b {
+		return [2]int{b, a}
+	}
+
+	return [2]int{a, b}
+}
+
+func nextHalfedge(e int) int {
+	if e%3 == 2 {
+		return e - 2
+	}
+
+	return e + 1
+}
+
+// sweep is the state of the Delaunator algorithm.
+type sweep struct {
+	coords       []float64
+	triangles    []int
+	halfedges    []int
+	trianglesLen int
+
+	hullStart int
+	hullPrev  []int
+	hullNext  []int
+	hullTri   []int
+	hullHash  []int
+	hull      []int
+
+	cx, cy float64
+}
+
+func newSweep(coords []float64) *sweep {
+	n := len(coords) / 2
+	maxTriangles := max(2*n-5, 0)
+	return &sweep{
+		coords:    coords,
+		triangles: make([]int, 3*maxTriangles),
+		halfedges: make([]int, 3*maxTriangles),
+		hullPrev:  make([]int, n),
+		hullNext:  make([]int, n),
+		hullTri:   make([]int, n),
+		hullHash:  make([]int, int(math.Ceil(math.Sqrt(float64(n))))),
+	}
+}
+
+func (s *sweep) run() {
+	coords := s.coords
+	n := len(coords) / 2
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	ids := make([]int, n)
+	for i := 0; i < n; i++ {
+		x, y := coords[2*i], coords[2*i+1]
+		minX, minY = min(minX, x), min(minY, y)
+		maxX, maxY = max(maxX, x), max(maxY, y)
+		ids[i] = i
+	}
+
+	cx, cy := (minX+maxX)/2, (minY+maxY)/2
+
+	// pick a seed point close to the center
+	i0, i1, i2 := 0, -1, -1
+	for i, minDist := 0, math.Inf(1); i < n; i++ {
+		if d := dist(cx, cy, coords[2*i], coords[2*i+1]); d < minDist {
+			i0, minDist = i, d
+		}
+	}
+
+	i0x, i0y := coords[2*i0], coords[2*i0+1]
+
+	// find the point closest to the seed
+	for i, minDist := 0, math.Inf(1); i < n; i++ {
+		if i == i0 {
+			continue
+		}
+
+		if d := dist(i0x, i0y, coords[2*i], coords[2*i+1]); d < minDist && d > 0 {
+			i1, minDist = i, d
+		}
+	}
+
+	// find the third point which forms the smallest circumcircle with the first two
+	minRadius := math.Inf(1)
+	if i1 >= 0 {
+		i1x, i1y := coords[2*i1], coords[2*i1+1]
+		for i := 0; i < n; i++ {
+			if i == i0 || i == i1 {
+				continue
+			}
+
+			if r := circumradius(i0x, i0y, i1x, i1y, coords[2*i], coords[2*i+1]); r < minRadius {
+				i2, minRadius = i, r
+			}
+		}
+	}
+
+	dists := make([]float64, n)
+	if math.IsInf(minRadius, 1) || math.IsNaN(minRadius) {
+		// order the collinear points by dx, or dy if all x are identical,
+		// and return the list as the hull
+		for i := 0; i < n; i++ {
+			dists[i] = coords[2*i] - coords[0]
+			if dists[i] == 0 {
+				dists[i] = coords[2*i+1] - coords[1]
+			}
+		}
+
+		sort.SliceStable(ids, func(i, j int) bool {
+			return dists[ids[i]] < dists[ids[j]]
+		})
+
+		d0 := math.Inf(-1)
+		for _, id := range ids {
+			if dists[id] > d0 {
+				s.hull = append(s.hull, id)
+				d0 = dists[id]
+			}
+		}
+
+		return
+	}
+
+	i1x, i1y := coords[2*i1], coords[2*i1+1]
+	i2x, i2y := coords[2*i2], coords[2*i2+1]
+
+	// swap the order of the seed points for counter-clockwise orientation
+	if orient(i0x, i0y, i1x, i1y, i2x, i2y) {
+		i1, i2 = i2, i1
+		i1x, i1y, i2x, i2y = i2x, i2y, i1x, i1y
+	}
+
+	s.cx, s.cy = circumcenter(i0x, i0y, i1x, i1y, i2x, i2y)
+	for i := 0; i < n; i++ {
+		dists[i] = dist(coords[2*i], coords[2*i+1], s.cx, s.cy)
+	}
+
+	// sort the points by distance from the seed triangle circumcenter
+	sort.SliceStable(ids, func(i, j int) bool {
+		return dists[ids[i]] < dists[ids[j]]
+	})
+
+	// set up the seed triangle as the starting hull
+	hullPrev, hullNext, hullTri, hullHash := s.hullPrev, s.hullNext, s.hullTri, s.hullHash
+	s.hullStart = i0
+	hullSize := 3
+
+	hullNext[i0], hullPrev[i2] = i1, i1
+	hullNext[i1], hullPrev[i0] = i2, i2
+	hullNext[i2], hullPrev[i1] = i0, i0
+
+	hullTri[i0], hullTri[i1], hullTri[i2] = 0, 1, 2
+
+	for i := range hullHash {
+		hullHash[i] = -1
+	}
+
+	hullHash[s.hashKey(i0x, i0y)] = i0
+	hullHash[s.hashKey(i1x, i1y)] = i1
+	hullHash[s.hashKey(i2x, i2y)] = i2
+
+	s.addTriangle(i0, i1, i2, -1, -1, -1)
+
+	var xp, yp float64
+	for k, i := range ids {
+		x, y := coords[2*i], coords[2*i+1]
+
+		// skip near-duplicate points
+		if k > 0 && math.Abs(x-xp) <= epsilon && math.Abs(y-yp) <= epsilon {
+			continue
+		}
+
+		xp, yp = x, y
+
+		// skip seed triangle points
+		if i == i0 || i == i1 || i == i2 {
+			continue
+		}
+
+		// find a visible edge on the convex hull using the edge hash
+		start := 0
+		for j, key := 0, s.hashKey(x, y); j < len(hullHash); j++ {
+			start = hullHash[(key+j)%len(hullHash)]
+			if start != -1 && start != hullNext[start] {
+				break
+			}
+		}
+
+		start = hullPrev[start]
+		e := start
+		for {
+			q := hullNext[e]
+			if orient(x, y, coords[2*e], coords[2*e+1], coords[2*q], coords[2*q+1]) {
+				break
+			}
+
+			if e = q; e == start {
+				e = -1
+				break
+			}
+		}
+
+		if e == -1 {
+			// likely a near-duplicate point
+			continue
+		}
+
+		// add the first triangle from the point
+		t := s.addTriangle(e, i, hullNext[e], -1, -1, hullTri[e])
+
+		// recursively flip triangles from the point until they satisfy the Delaunay condition
+		hullTri[i] = s.legalize(t + 2)
+		hullTri[e] = t // keep track of boundary triangles on the hull
+		hullSize++
+
+		// walk forward through the hull, adding more triangles and flipping recursively
+		next := hullNext[e]
+		for {
+			q := hullNext[next]
+			if !orient(x, y, coords[2*next], coords[2*next+1], coords[2*q], coords[2*q+1]) {
+				break
+			}
+
+			t = s.addTriangle(next, i, q, hullTri[i], -1, hullTri[next])
+			hullTri[i] = s.legalize(t + 2)
+			hullNext[next] = next // mark as removed
+			hullSize--
+			next = q
+		}
+
+		// walk backward from the other side, adding more triangles and flipping
+		if e == start {
+			for {
+				q := hullPrev[e]
+				if !orient(x, y, coords[2*q], coords[2*q+1], coords[2*e], coords[2*e+1]) {
+					break
+				}
+
+				t = s.addTriangle(q, i, e, -1, hullTri[e], hullTri[q])
+				s.legalize(t + 2)
+				hullTri[q] = t
+				hullNext[e] = e // mark as removed
+				hullSize--
+				e = q
+			}
+		}
+
+		// update the hull indices
+		s.hullStart = e
+		hullPrev[i] = e
+		hullNext[e] = i
+		hullPrev[next] = i
+		hullNext[i] = next
+
+		// save the two new edges in the hash table
+		hullHash[s.hashKey(x, y)] = i
+		hullHash[s.hashKey(coords[2*e], coords[2*e+1])] = e
+	}
+
+	s.hull = make([]int, 0, hullSize)
+	for i, e := 0, s.hullStart; i < hullSize; i++ {
+		s.hull = append(s.hull, e)
+		e = hullNext[e]
+	}
+}
+
+func (s *sweep) hashKey(x, y float64) int {
+	n := len(s.hullHash)
+	return int(math.Floor(pseudoAngle(x-s.cx, y-s.cy)*float64(n))) % n
+}
+
+// legalize flips the triangles of the halfedge, and the ones of the new edges,
+// until they satisfy the Delaunay condition.
+func (s *sweep) legalize(a int) int {
+	var stack []int
+	ar := 0
+	for {
+		b := s.halfedges[a]
+		a0 := a - a%3
+		ar = a0 + (a+2)%3
+
+		if b == -1 {
+			// convex hull edge
+			if len(stack) == 0 {
+				break
+			}
+
+			a, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			continue
+		}
+
+		b0 := b - b%3
+		al := a0 + (a+1)%3
+		bl := b0 + (b+2)%3
+
+		p0 := s.triangles[ar]
+		pr := s.triangles[a]
+		pl := s.triangles[al]
+		p1 := s.triangles[bl]
+
+		c := s.coords
+		illegal := inCircle(
+			c[2*p0], c[2*p0+1],
+			c[2*pr], c[2*pr+1],
+			c[2*pl], c[2*pl+1],
+			c[2*p1], c[2*p1+1],
+		)
+
+		if !illegal {
+			if len(stack) == 0 {
+				break
+			}
+
+			a, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			continue
+		}
+
+		s.triangles[a] = p1
+		s.triangles[b] = p0
+
+		hbl := s.halfedges[bl]
+
+		// edge swapped on the other side of the hull (rare), fix the halfedge reference
+		if hbl == -1 {
+			e := s.hullStart
+			for {
+				if s.hullTri[e] == bl {
+					s.hullTri[e] = a
+					break
+				}
+
+				if e = s.hullPrev[e]; e == s.hullStart {
+					break
+				}
+			}
+		}
+
+		s.link(a, hbl)
+		s.link(b, s.halfedges[ar])
+		s.link(ar, bl)
+
+		stack = append(stack, b0+(b+1)%3)
+	}
+
+	return ar
+}
+
+func (s *sweep) link(a, b int) {
+	s.halfedges[a] = b
+	if b != -1 {
+		s.halfedges[b] = a
+	}
+}
+
+// addTriangle adds a triangle and links its halfedges, returns its first halfedge.
+func (s *sweep) addTriangle(i0, i1, i2, a, b, c int) int {
+	t := s.trianglesLen
+	s.triangles[t] = i0
+	s.triangles[t+1] = i1
+	s.triangles[t+2] = i2
+
+	s.link(t, a)
+	s.link(t+1, b)
+	s.link(t+2, c)
+
+	s.trianglesLen += 3
+	return t
+}
+
+const epsilon = 1.0 / (1 << 52)
+
+// pseudoAngle monotonically increases with the real angle, but doesn't need trigonometry.
+func pseudoAngle(dx, dy float64) float64 {
+	if dx == 0 && dy == 0 {
+		return 0
+	}
+
+	p := dx / (math.Abs(dx) + math.Abs(dy))
+	if dy > 0 {
+		return (3 - p) / 4
+	}
+
+	return (1 + p) / 4
+}
+
+func dist(ax, ay, bx, by float64) float64 {
+	dx, dy := ax-bx, ay-by
+	return dx*dx + dy*dy
+}
+
+// orient returns true if the points are in counter-clockwise order in screen coordinates.
+func orient(px, py, qx, qy, rx, ry float64) bool {
+	return (qy-py)*(rx-qx)-(qx-px)*(ry-qy) < 0
+}
+
+func inCircle(ax, ay, bx, by, cx, cy, px, py float64) bool {
+	dx, dy := ax-px, ay-py
+	ex, ey := bx-px, by-py
+	fx, fy := cx-px, cy-py
+
+	ap := dx*dx + dy*dy
+	bp := ex*ex + ey*ey
+	cp := fx*fx + fy*fy
+
+	return dx*(ey*cp-bp*fy)-dy*(ex*cp-bp*fx)+ap*(ex*fy-ey*fx) < 0
+}
+
+// circumradius returns the squared radius of the circle through the points.
+func circumradius(ax, ay, bx, by, cx, cy float64) float64 {
+	x, y := circumcenterOffset(ax, ay, bx, by, cx, cy)
+	return x*x + y*y
+}
+
+func circumcenter(ax, ay, bx, by, cx, cy float64) (float64, float64) {
+	x, y := circumcenterOffset(ax, ay, bx, by, cx, cy)
+	return ax + x, ay + y
+}
+
+// circumcenterOffset returns the circumcenter relative to the first point.
+func circumcenterOffset(ax, ay, bx, by, cx, cy float64) (float64, float64) {
+	dx, dy := bx-ax, by-ay
+	ex, ey := cx-ax, cy-ay
+
+	bl := dx*dx + dy*dy
+	cl := ex*ex + ey*ey
+	d := 0.5 / (dx*ey - dy*ex)
+
+	return (ey*bl - dy*cl) * d, (dx*cl - ex*bl) * d
+}
@@ -0,0 +1,168 @@
+package triangulate
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+	"github.com/pchchv/geo/quadtree"
+)
+
+func TestNewDelaunay(t *testing.T) {
+	points := geo.MultiPoint{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}}
+	d := NewDelaunay(points)
+	if d.Len() != 4 {
+		t.Errorf("incorrect number of triangles: %v", d.Len())
+	}
+
+	expected := [][2]int{{0, 1}, {0, 3}, {0, 4}, {1, 2}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}
+	if e := d.Edges(); !reflect.DeepEqual(e, expected) {
+		t.Errorf("incorrect edges: %v != %v", e, expected)
+	}
+
+	if len(d.Hull) != 4 {
+		t.Errorf("incorrect hull: %v", d.Hull)
+	}
+
+	hull := geo.Ring{}
+	for _, i := range d.Hull {
+		hull = append(hull, points[i])
+	}
+
+	if hull = append(hull, hull[0]); hull.Orientation() != geo.CCW {
+		t.Errorf("hull should be counter-clockwise: %v", hull)
+	}
+}
+
+func TestNewDelaunay_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	points := make(geo.MultiPoint, 0, 500)
+	for i := 0; i < 500; i++ {
+		points = append(points, geo.Point{r.Float64() * 100, r.Float64() * 100})
+	}
+
+	d := NewDelaunay(points)
+	if n := 2*len(points) - 2 - len(d.Hull); d.Len() != n {
+		t.Errorf("incorrect number of triangles: %v != %v", d.Len(), n)
+	}
+
+	if n := 3*len(points) - 3 - len(d.Hull); len(d.Edges()) != n {
+		t.Errorf("incorrect number of edges: %v != %v", len(d.Edges()), n)
+	}
+
+	area := 0.0
+	for i := 0; i < d.Len(); i++ {
+		tr := d.Triangle(i)
+		if tr.Orientation() != geo.CCW {
+			t.Fatalf("triangle should be counter-clockwise: %v", tr)
+		}
+
+		area += planar.Area(tr)
+
+		// no point is inside the circumcircle
+		x, y := circumcenter(tr[0][0], tr[0][1], tr[1][0], tr[1][1], tr[2][0], tr[2][1])
+		radius := planar.DistanceSquared(geo.Point{x, y}, tr[0])
+		for _, p := range points {
+			if planar.DistanceSquared(geo.Point{x, y}, p) < radius*(1-1e-9) {
+				t.Fatalf("point %v inside the circumcircle of %v", p, tr)
+			}
+		}
+	}
+
+	if hull := planar.Area(planar.ConvexHull(points)); math.Abs(area-hull) > 1e-9 {
+		t.Errorf("triangles should cover the hull: %v != %v", area, hull)
+	}
+}
+
+func TestNewDelaunay_degenerate(t *testing.T) {
+	cases := []struct {
+		name   string
+		points geo.MultiPoint
+		hull   []int
+		edges  [][2]int
+	}{
+		{
+			name:   "empty",
+			points: geo.MultiPoint{},
+		},
+		{
+			name:   "one point",
+			points: geo.MultiPoint{{1, 1}},
+			hull:   []int{0},
+		},
+		{
+			name:   "collinear",
+			points: geo.MultiPoint{{2, 2}, {0, 0}, {1, 1}, {3, 3}},
+			hull:   []int{1, 2, 0, 3},
+			edges:  [][2]int{{0, 2}, {0, 3}, {1, 2}},
+		},
+		{
+			name:   "vertical",
+			points: geo.MultiPoint{{0, 1}, {0, 0}},
+			hull:   []int{0, 1},
+			edges:  [][2]int{{0, 1}},
+		},
+		{
+			name:   "duplicates",
+			points: geo.MultiPoint{{0, 0}, {0, 0}, {1, 1}, {1, 1}},
+			hull:   []int{0, 2},
+			edges:  [][2]int{{0, 2}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewDelaunay(tc.points)
+			if d.Len() != 0 {
+				t.Errorf("should have no triangles: %v", d.Triangles)
+			}
+
+			if !reflect.DeepEqual(d.Hull, tc.hull) {
+				t.Errorf("incorrect hull: %v != %v", d.Hull, tc.hull)
+			}
+
+			if e := d.Edges(); !reflect.DeepEqual(e, tc.edges) {
+				t.Errorf("incorrect edges: %v != %v", e, tc.edges)
+			}
+		})
+	}
+}
+
+func TestNewDelaunay_duplicates(t *testing.T) {
+	points := geo.MultiPoint{{0, 0}, {1, 0}, {0, 1}, {1, 0}, {1, 1}, {0, 0}}
+	d := NewDelaunay(points)
+	if d.Len() != 2 {
+		t.Errorf("incorrect number of triangles: %v", d.Len())
+	}
+
+	if a := planar.Area(d.MultiPolygon()); a != 1 {
+		t.Errorf("incorrect area: %v", a)
+	}
+}
+
+func TestNewDelaunayQuadtree(t *testing.T) {
+	q := quadtree.New(geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{10, 10}})
+	for _, p := range []geo.Point{{1, 1}, {9, 1}, {5, 9}, {5, 4}} {
+		if err := q.Add(p); err != nil {
+			t.Fatalf("add error: %v", err)
+		}
+	}
+
+	d, pointers := NewDelaunayQuadtree(q)
+	if len(pointers) != 4 || d.Len() != 3 {
+		t.Fatalf("incorrect triangulation: %v %v", pointers, d.Triangles)
+	}
+
+	for i, p := range pointers {
+		if d.Points[i] != p.Point() {
+			t.Errorf("incorrect point: %v != %v", d.Points[i], p.Point())
+		}
+	}
+
+	if l := len(d.MultiLineString()); l != 6 {
+		t.Errorf("incorrect number of edges: %v", l)
+	}
+}
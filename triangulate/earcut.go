@@ -0,0 +1,618 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+)
+
+// The ear clipping follows earcut by Mapbox, https://github.com/mapbox/earcut,
+// with z-order hashing of the vertices of larger polygons.
+
+type node struct {
+	i    int // index of the vertex
+	x, y float64
+	z    int32 // z-order curve value
+
+	prev, next   *node
+	prevZ, nextZ *node
+
+	steiner bool
+}
+
+// earcut appends the indexes of the triangles of the polygon given by
+// the flat coordinates, the holes start at the given vertex indexes.
+func earcut(indices []int, coords []float64, holes []int) []int {
+	outerLen := len(coords)
+	if len(holes) > 0 {
+		outerLen = 2 * holes[0]
+	}
+
+	outer := linkedList(coords, 0, outerLen, true)
+	if outer == nil || outer.next == outer.prev {
+		return indices
+	}
+
+	if len(holes) > 0 {
+		outer = eliminateHoles(coords, holes, outer)
+	}
+
+	// use the z-order curve hash for larger polygons
+	var minX, minY, invSize float64
+	if len(coords) > 80*2 {
+		minX, minY = coords[0], coords[1]
+		maxX, maxY := minX, minY
+		for i := 2; i < outerLen; i += 2 {
+			x, y := coords[i], coords[i+1]
+			minX, minY = min(minX, x), min(minY, y)
+			maxX, maxY = max(maxX, x), max(maxY, y)
+		}
+
+		if size := max(maxX-minX, maxY-minY); size != 0 {
+			invSize = 32767 / size
+		}
+	}
+
+	e := &earcutter{indices: indices, minX: minX, minY: minY, invSize: invSize}
+	e.linked(outer, 0)
+	return e.indices
+}
+
+type earcutter struct {
+	indices    []int
+	minX, minY float64
+	invSize    float64
+}
+
+// linked cuts the ears of the list, if no ear is left the list is filtered in a first pass,
+// local self-intersections are cured in a second pass and the polygon is split in a third.
+func (e *earcutter) linked(ear *node, pass int) {
+	if ear == nil {
+		return
+	}
+
+	if pass == 0 && e.invSize != 0 {
+		indexCurve(ear, e.minX, e.minY, e.invSize)
+	}
+
+	stop := ear
+	for ear.prev != ear.next {
+		prev, next := ear.prev, ear.next
+
+		var isEar bool
+		if e.invSize != 0 {
+			isEar = isEarHashed(ear, e.minX, e.minY, e.invSize)
+		} else {
+			isEar = isEarLinear(ear)
+		}
+
+		if isEar {
+			e.indices = append(e.indices, prev.i, ear.i, next.i)
+			removeNode(ear)
+
+			// skipping the next vertex leads to less sliver triangles
+			ear = next.next
+			stop = next.next
+			continue
+		}
+
+		ear = next
+		if ear == stop {
+			switch pass {
+			case 0:
+				e.linked(filterPoints(ear, nil), 1)
+			case 1:
+				ear = e.cureLocalIntersections(filterPoints(ear, nil))
+				e.linked(ear, 2)
+			case 2:
+				e.split(ear)
+			}
+
+			break
+		}
+	}
+}
+
+// cureLocalIntersections removes the vertices of small self-intersections.
+func (e *earcutter) cureLocalIntersections(start *node) *node {
+	p := start
+	for {
+		a, b := p.prev, p.next.next
+		if !equals(a, b) && intersects(a, p, p.next, b) && locallyInside(a, b) && locallyInside(b, a) {
+			e.indices = append(e.indices, a.i, p.i, b.i)
+			removeNode(p)
+			removeNode(p.next)
+			p, start = b, b
+		}
+
+		if p = p.next; p == start {
+			break
+		}
+	}
+
+	return filterPoints(p, nil)
+}
+
+// split looks for a valid diagonal that divides the polygon into two and triangulates both.
+func (e *earcutter) split(start *node) {
+	a := start
+	for {
+		for b := a.next.next; b != a.prev; b = b.next {
+			if a.i != b.i && isValidDiagonal(a, b) {
+				c := splitPolygon(a, b)
+				a = filterPoints(a, a.next)
+				c = filterPoints(c, c.next)
+				e.linked(a, 0)
+				e.linked(c, 0)
+				return
+			}
+		}
+
+		if a = a.next; a == start {
+			return
+		}
+	}
+}
+
+// linkedList creates a circular list from the vertices in the given orientation.
+func linkedList(coords []float64, start, end int, ccw bool) *node {
+	var last *node
+	if ccw == (signedArea(coords, start, end) > 0) {
+		for i := start; i < end; i += 2 {
+			last = insertNode(i/2, coords[i], coords[i+1], last)
+		}
+	} else {
+		for i := end - 2; i >= start; i -= 2 {
+			last = insertNode(i/2, coords[i], coords[i+1], last)
+		}
+	}
+
+	if last != nil && equals(last, last.next) {
+		removeNode(last)
+		last = last.next
+	}
+
+	return last
+}
+
+// filterPoints removes duplicate and collinear points.
+func filterPoints(start, end *node) *node {
+	if start == nil {
+		return start
+	}
+
+	if end == nil {
+		end = start
+	}
+
+	p := start
+	for {
+		again := false
+		if !p.steiner && (equals(p, p.next) || area(p.prev, p, p.next) == 0) {
+			removeNode(p)
+			p = p.prev
+			end = p
+			if p == p.next {
+				break
+			}
+
+			again = true
+		} else {
+			p = p.next
+		}
+
+		if !again && p == end {
+			break
+		}
+	}
+
+	return end
+}
+
+// isEarLinear checks if the convex vertex is an ear by
+// making sure no other point is inside the triangle.
+func isEarLinear(ear *node) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if area(a, b, c) >= 0 {
+		// reflex
+		return false
+	}
+
+	x0, y0 := min(a.x, b.x, c.x), min(a.y, b.y, c.y)
+	x1, y1 := max(a.x, b.x, c.x), max(a.y, b.y, c.y)
+	for p := c.next; p != a; p = p.next {
+		if p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 &&
+			pointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) &&
+			area(p.prev, p, p.next) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isEarHashed is isEarLinear looking only at the points in the z-order range of the triangle.
+func isEarHashed(ear *node, minX, minY, invSize float64) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if area(a, b, c) >= 0 {
+		// reflex
+		return false
+	}
+
+	x0, y0 := min(a.x, b.x, c.x), min(a.y, b.y, c.y)
+	x1, y1 := max(a.x, b.x, c.x), max(a.y, b.y, c.y)
+	minZ := zOrder(x0, y0, minX, minY, invSize)
+	maxZ := zOrder(x1, y1, minX, minY, invSize)
+
+	inside := func(p *node) bool {
+		return p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 &&
+			p != a && p != c &&
+			pointInTriangle(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y) &&
+			area(p.prev, p, p.next) >= 0
+	}
+
+	// look for points inside the triangle in both directions
+	p, n := ear.prevZ, ear.nextZ
+	for p != nil && p.z >= minZ && n != nil && n.z <= maxZ {
+		if inside(p) || inside(n) {
+			return false
+		}
+
+		p, n = p.prevZ, n.nextZ
+	}
+
+	for ; p != nil && p.z >= minZ; p = p.prevZ {
+		if inside(p) {
+			return false
+		}
+	}
+
+	for ; n != nil && n.z <= maxZ; n = n.nextZ {
+		if inside(n) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eliminateHoles links the holes into the outer ring, starting with the leftmost hole.
+func eliminateHoles(coords []float64, holes []int, outer *node) *node {
+	queue := make([]*node, 0, len(holes))
+	for i, h := range holes {
+		end := len(coords)
+		if i < len(holes)-1 {
+			end = 2 * holes[i+1]
+		}
+
+		list := linkedList(coords, 2*h, end, false)
+		if list == nil {
+			continue
+		}
+
+		if list == list.next {
+			list.steiner = true
+		}
+
+		queue = append(queue, leftmost(list))
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		return queue[i].x < queue[j].x
+	})
+
+	for _, h := range queue {
+		outer = eliminateHole(h, outer)
+	}
+
+	return outer
+}
+
+// eliminateHole connects the hole with the outer ring by a bridge.
+func eliminateHole(hole, outer *node) *node {
+	bridge := findHoleBridge(hole, outer)
+	if bridge == nil {
+		return outer
+	}
+
+	bridgeReverse := splitPolygon(bridge, hole)
+
+	// filter the collinear points around the cuts
+	filterPoints(bridgeReverse, bridgeReverse.next)
+	return filterPoints(bridge, bridge.next)
+}
+
+// findHoleBridge uses David Eberly's algorithm to find a vertex of
+// the outer ring that can be connected to the leftmost point of the hole.
+func findHoleBridge(hole, outer *node) *node {
+	hx, hy := hole.x, hole.y
+	qx := math.Inf(-1)
+
+	// find a segment intersected by a ray from the hole's leftmost point to the left,
+	// the segment's endpoint with lesser x will be a potential connection point
+	var m *node
+	p := outer
+	for {
+		if hy <= p.y && hy >= p.next.y && p.next.y != p.y {
+			x := p.x + (hy-p.y)*(p.next.x-p.x)/(p.next.y-p.y)
+			if x <= hx && x > qx {
+				qx = x
+				m = p
+				if p.next.x < p.x {
+					m = p.next
+				}
+
+				if x == hx {
+					// the hole touches the outer segment, pick the leftmost endpoint
+					return m
+				}
+			}
+		}
+
+		if p = p.next; p == outer {
+			break
+		}
+	}
+
+	if m == nil {
+		return nil
+	}
+
+	// look for points inside the triangle of the hole point, the segment intersection
+	// and the endpoint, if there are none the endpoint is the connection point,
+	// otherwise the point of the minimum angle with the ray is
+	stop := m
+	mx, my := m.x, m.y
+	tanMin := math.Inf(1)
+	p = m
+	for {
+		ax, cx := qx, hx
+		if hy < my {
+			ax, cx = hx, qx
+		}
+
+		if hx >= p.x && p.x >= mx && hx != p.x && pointInTriangle(ax, hy, mx, my, cx, hy, p.x, p.y) {
+			tan := math.Abs(hy-p.y) / (hx - p.x)
+			if locallyInside(p, hole) &&
+				(tan < tanMin || (tan == tanMin && (p.x > m.x || (p.x == m.x && sectorContainsSector(m, p))))) {
+				m = p
+				tanMin = tan
+			}
+		}
+
+		if p = p.next; p == stop {
+			break
+		}
+	}
+
+	return m
+}
+
+// sectorContainsSector checks whether the sector in vertex m contains the sector in vertex p.
+func sectorContainsSector(m, p *node) bool {
+	return area(m.prev, m, p.prev) < 0 && area(p.next, m, m.next) < 0
+}
+
+// indexCurve sets the z-order values and sorts the nodes by them.
+func indexCurve(start *node, minX, minY, invSize float64) {
+	var nodes []*node
+	p := start
+	for {
+		if p.z == 0 {
+			p.z = zOrder(p.x, p.y, minX, minY, invSize)
+		}
+
+		nodes = append(nodes, p)
+		if p = p.next; p == start {
+			break
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].z < nodes[j].z
+	})
+
+	for i, n := range nodes {
+		n.prevZ, n.nextZ = nil, nil
+		if i > 0 {
+			n.prevZ = nodes[i-1]
+		}
+
+		if i < len(nodes)-1 {
+			n.nextZ = nodes[i+1]
+		}
+	}
+}
+
+// zOrder returns the z-order of the point given the coordinates
+// and the inverse of the size of the bound of the polygon.
+func zOrder(x, y, minX, minY, invSize float64) int32 {
+	ix := int32((x - minX) * invSize)
+	iy := int32((y - minY) * invSize)
+
+	ix = (ix | (ix << 8)) & 0x00FF00FF
+	ix = (ix | (ix << 4)) & 0x0F0F0F0F
+	ix = (ix | (ix << 2)) & 0x33333333
+	ix = (ix | (ix << 1)) & 0x55555555
+
+	iy = (iy | (iy << 8)) & 0x00FF00FF
+	iy = (iy | (iy << 4)) & 0x0F0F0F0F
+	iy = (iy | (iy << 2)) & 0x33333333
+	iy = (iy | (iy << 1)) & 0x55555555
+
+	return ix | (iy << 1)
+}
+
+// leftmost returns the leftmost node of the list.
+func leftmost(start *node) *node {
+	p, left := start, start
+	for {
+		if p.x < left.x || (p.x == left.x && p.y < left.y) {
+			left = p
+		}
+
+		if p = p.next; p == start {
+			return left
+		}
+	}
+}
+
+// pointInTriangle checks if the point p is inside the triangle.
+func pointInTriangle(ax, ay, bx, by, cx, cy, px, py float64) bool {
+	return (cx-px)*(ay-py) >= (ax-px)*(cy-py) &&
+		(ax-px)*(by-py) >= (bx-px)*(ay-py) &&
+		(bx-px)*(cy-py) >= (cx-px)*(by-py)
+}
+
+// isValidDiagonal checks if the diagonal from a to b can split the polygon into two.
+func isValidDiagonal(a, b *node) bool {
+	if a.next.i == b.i || a.prev.i == b.i || intersectsPolygon(a, b) {
+		return false
+	}
+
+	// locally visible and no opposite-facing sectors
+	if locallyInside(a, b) && locallyInside(b, a) && middleInside(a, b) &&
+		(area(a.prev, a, b.prev) != 0 || area(a, b.prev, b) != 0) {
+		return true
+	}
+
+	// the special zero-length case
+	return equals(a, b) && area(a.prev, a, a.next) > 0 && area(b.prev, b, b.next) > 0
+}
+
+// area returns the signed area of the triangle, negative if it is counter-clockwise.
+func area(p, q, r *node) float64 {
+	return (q.y-p.y)*(r.x-q.x) - (q.x-p.x)*(r.y-q.y)
+}
+
+func equals(p1, p2 *node) bool {
+	return p1.x == p2.x && p1.y == p2.y
+}
+
+// intersects checks if the segments p1-q1 and p2-q2 intersect.
+func intersects(p1, q1, p2, q2 *node) bool {
+	o1 := sign(area(p1, q1, p2))
+	o2 := sign(area(p1, q1, q2))
+	o3 := sign(area(p2, q2, p1))
+	o4 := sign(area(p2, q2, q1))
+
+	if o1 != o2 && o3 != o4 {
+		return true
+	}
+
+	// the collinear cases
+	return (o1 == 0 && onSegment(p1, p2, q1)) ||
+		(o2 == 0 && onSegment(p1, q2, q1)) ||
+		(o3 == 0 && onSegment(p2, p1, q2)) ||
+		(o4 == 0 && onSegment(p2, q1, q2))
+}
+
+// onSegment checks if the collinear point q is on the segment p-r.
+func onSegment(p, q, r *node) bool {
+	return q.x <= max(p.x, r.x) && q.x >= min(p.x, r.x) &&
+		q.y <= max(p.y, r.y) && q.y >= min(p.y, r.y)
+}
+
+// intersectsPolygon checks if the diagonal intersects any edge of the polygon.
+func intersectsPolygon(a, b *node) bool {
+	p := a
+	for {
+		if p.i != a.i && p.next.i != a.i && p.i != b.i && p.next.i != b.i && intersects(p, p.next, a, b) {
+			return true
+		}
+
+		if p = p.next; p == a {
+			return false
+		}
+	}
+}
+
+// locallyInside checks if the diagonal starts inside the polygon at a.
+func locallyInside(a, b *node) bool {
+	if area(a.prev, a, a.next) < 0 {
+		return area(a, b, a.next) >= 0 && area(a, a.prev, b) >= 0
+	}
+
+	return area(a, b, a.prev) < 0 || area(a, a.next, b) < 0
+}
+
+// middleInside checks if the middle of the diagonal is inside the polygon.
+func middleInside(a, b *node) bool {
+	inside := false
+	px, py := (a.x+b.x)/2, (a.y+b.y)/2
+	p := a
+	for {
+		if (p.y > py) != (p.next.y > py) && p.next.y != p.y &&
+			px < (p.next.x-p.x)*(py-p.y)/(p.next.y-p.y)+p.x {
+			inside = !inside
+		}
+
+		if p = p.next; p == a {
+			return inside
+		}
+	}
+}
+
+// splitPolygon links a to b with a bridge, splitting the polygon into two.
+// If a and b are of different rings they are merged into one.
+// Returns the copy of b in the second polygon.
+func splitPolygon(a, b *node) *node {
+	a2 := &node{i: a.i, x: a.x, y: a.y}
+	b2 := &node{i: b.i, x: b.x, y: b.y}
+	an, bp := a.next, b.prev
+
+	a.next, b.prev = b, a
+	a2.next, an.prev = an, a2
+	b2.next, a2.prev = a2, b2
+	bp.next, b2.prev = b2, bp
+
+	return b2
+}
+
+// insertNode creates a node after the last one.
+func insertNode(i int, x, y float64, last *node) *node {
+	p := &node{i: i, x: x, y: y}
+	if last == nil {
+		p.prev, p.next = p, p
+	} else {
+		p.next, p.prev = last.next, last
+		last.next.prev = p
+		last.next = p
+	}
+
+	return p
+}
+
+func removeNode(p *node) {
+	p.next.prev = p.prev
+	p.prev.next = p.next
+
+	if p.prevZ != nil {
+		p.prevZ.nextZ = p.nextZ
+	}
+
+	if p.nextZ != nil {
+		p.nextZ.prevZ = p.prevZ
+	}
+}
+
+// signedArea returns twice the area of the ring given by the flat coordinates,
+// positive if it is counter-clockwise.
+func signedArea(coords []float64, start, end int) (sum float64) {
+	for i, j := start, end-2; i < end; i += 2 {
+		sum += (coords[j] - coords[i]) * (coords[i+1] + coords[j+1])
+		j = i
+	}
+
+	return sum
+}
+
+func sign(v float64) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+
+	return 0
+}
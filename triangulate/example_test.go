@@ -0,0 +1,39 @@
+package triangulate_test
+
+import (
+	"fmt"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/triangulate"
+)
+
+func ExamplePolygon() {
+	p := geo.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+	}
+
+	t := triangulate.Polygon(p)
+	fmt.Println(t.Vertices)
+	fmt.Println(t.Indices)
+	fmt.Println(t.Deviation(p))
+
+	// Output:
+	// [0 0 4 0 4 4 0 4 1 1 1 3 3 3 3 1]
+	// [4 3 0 3 4 5 7 4 0 2 3 5 7 0 1 2 5 6 6 7 1 1 2 6]
+	// 0
+}
+
+func ExampleVoronoi() {
+	stores := geo.MultiPoint{{1, 1}, {3, 1}, {2, 3}}
+	bound := geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{4, 4}}
+
+	for _, c := range triangulate.Voronoi(stores, bound) {
+		fmt.Println(c.Site, c.Polygon)
+	}
+
+	// Output:
+	// 0 [[[0 0] [2 0] [2 1.75] [0 2.75] [0 0]]]
+	// 1 [[[2 0] [4 0] [4 2.75] [2 1.75] [2 0]]]
+	// 2 [[[4 2.75] [4 4] [0 4] [0 2.75] [2 1.75] [4 2.75]]]
+}
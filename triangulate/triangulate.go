@@ -0,0 +1,154 @@
+// Package triangulate splits polygons into triangles, e.g. for rendering with WebGL,
+// and computes Delaunay triangulations and Voronoi diagrams of points.
+package triangulate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+// Triangles is a triangulation as a flat array of the vertex coordinates,
+// x then y for each vertex, and three vertex indexes for each triangle.
+// The vertices are the points of the rings without the closing point,
+// in the order they appear in the geometry.
+type Triangles struct {
+	Vertices []float64
+	Indices  []int
+}
+
+// Polygon triangulates the polygon, holes included,
+// using ear clipping as in the earcut library.
+func Polygon(p geo.Polygon) Triangles {
+	t := Triangles{}
+	t.polygon(p)
+	return t
+}
+
+// MultiPolygon triangulates each polygon of the multi polygon.
+func MultiPolygon(mp geo.MultiPolygon) Triangles {
+	t := Triangles{}
+	for _, p := range mp {
+		t.polygon(p)
+	}
+
+	return t
+}
+
+// Geometry triangulates the 2d parts of the geometry,
+// points and lines have no triangles.
+func Geometry(g geo.Geometry) Triangles {
+	t := Triangles{}
+	t.geometry(g)
+	return t
+}
+
+func (t *Triangles) geometry(g geo.Geometry) {
+	switch g := g.(type) {
+	case nil:
+	case geo.Point, geo.MultiPoint, geo.LineString, geo.MultiLineString:
+	case geo.Ring:
+		t.polygon(geo.Polygon{g})
+	case geo.Polygon:
+		t.polygon(g)
+	case geo.MultiPolygon:
+		for _, p := range g {
+			t.polygon(p)
+		}
+	case geo.Collection:
+		for _, c := range g {
+			t.geometry(c)
+		}
+	case geo.Bound:
+		if !g.IsEmpty() {
+			t.polygon(g.ToPolygon())
+		}
+	default:
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (t *Triangles) polygon(p geo.Polygon) {
+	if len(p) == 0 {
+		return
+	}
+
+	offset := len(t.Vertices) / 2
+	var holes []int
+	for i, r := range p {
+		if len(r) > 1 && r[0] == r[len(r)-1] {
+			r = r[:len(r)-1]
+		}
+
+		if i > 0 {
+			holes = append(holes, len(t.Vertices)/2-offset)
+		}
+
+		for _, v := range r {
+			t.Vertices = append(t.Vertices, v[0], v[1])
+		}
+	}
+
+	start := len(t.Indices)
+	t.Indices = earcut(t.Indices, t.Vertices[2*offset:], holes)
+	for i := start; i < len(t.Indices); i++ {
+		t.Indices[i] += offset
+	}
+}
+
+// Len returns the number of triangles.
+func (t Triangles) Len() int {
+	return len(t.Indices) / 3
+}
+
+// Triangle returns the i-th triangle as a closed counter-clockwise ring.
+func (t Triangles) Triangle(i int) geo.Ring {
+	r := make(geo.Ring, 0, 4)
+	for _, v := range t.Indices[3*i : 3*i+3] {
+		r = append(r, geo.Point{t.Vertices[2*v], t.Vertices[2*v+1]})
+	}
+
+	r = append(r, r[0])
+	if r.Orientation() == geo.CW {
+		r.Reverse()
+	}
+
+	return r
+}
+
+// MultiPolygon returns the triangles as polygons.
+func (t Triangles) MultiPolygon() geo.MultiPolygon {
+	mp := make(geo.MultiPolygon, 0, t.Len())
+	for i := 0; i < t.Len(); i++ {
+		mp = append(mp, geo.Polygon{t.Triangle(i)})
+	}
+
+	return mp
+}
+
+// Area returns the total area of the triangles.
+func (t Triangles) Area() (sum float64) {
+	for i := 0; i < len(t.Indices); i += 3 {
+		a, b, c := t.Indices[i], t.Indices[i+1], t.Indices[i+2]
+		ax, ay := t.Vertices[2*a], t.Vertices[2*a+1]
+		bx, by := t.Vertices[2*b], t.Vertices[2*b+1]
+		cx, cy := t.Vertices[2*c], t.Vertices[2*c+1]
+		sum += math.Abs((ax-cx)*(by-ay)-(ax-bx)*(cy-ay)) / 2
+	}
+
+	return sum
+}
+
+// Deviation returns the relative difference between the area of the triangles and
+// the planar.Area of the geometry that was triangulated. It is zero if the triangulation
+// is correct and can be used to catch bad results for degenerate or invalid rings.
+func (t Triangles) Deviation(g geo.Geometry) float64 {
+	area, triangles := planar.Area(g), t.Area()
+	if area == 0 && triangles == 0 {
+		return 0
+	}
+
+	return math.Abs((triangles - area) / area)
+}
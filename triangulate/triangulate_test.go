@@ -0,0 +1,179 @@
+package triangulate
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestPolygon(t *testing.T) {
+	cases := []struct {
+		name      string
+		polygon   geo.Polygon
+		triangles int
+	}{
+		{
+			name:      "square",
+			polygon:   geo.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+			triangles: 2,
+		},
+		{
+			name:      "clockwise square",
+			polygon:   geo.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}},
+			triangles: 2,
+		},
+		{
+			name:      "unclosed ring",
+			polygon:   geo.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			triangles: 2,
+		},
+		{
+			name: "concave",
+			polygon: geo.Polygon{
+				{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}, {0, 0}},
+			},
+			triangles: 6,
+		},
+		{
+			name: "with holes",
+			polygon: geo.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{1, 1}, {1, 4}, {4, 4}, {4, 1}, {1, 1}},
+				{{6, 6}, {6, 9}, {9, 9}, {9, 6}, {6, 6}},
+			},
+			triangles: 14,
+		},
+		{
+			name:      "hole touching the shell",
+			polygon:   geo.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, {{0, 1}, {2, 2}, {2, 1}, {0, 1}}},
+			triangles: 6,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := Polygon(tc.polygon)
+			if tr.Len() != tc.triangles {
+				t.Errorf("incorrect number of triangles: %v != %v", tr.Len(), tc.triangles)
+			}
+
+			if d := tr.Deviation(tc.polygon); d > 1e-12 {
+				t.Errorf("incorrect deviation: %v", d)
+			}
+
+			for i := 0; i < tr.Len(); i++ {
+				if planar.Area(tr.Triangle(i)) <= 0 {
+					t.Errorf("degenerate triangle: %v", tr.Triangle(i))
+				}
+			}
+		})
+	}
+}
+
+func TestPolygon_vertices(t *testing.T) {
+	p := geo.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+	}
+
+	tr := Polygon(p)
+	expected := []float64{0, 0, 4, 0, 4, 4, 0, 4, 1, 1, 1, 2, 2, 2, 2, 1}
+	if !reflect.DeepEqual(tr.Vertices, expected) {
+		t.Errorf("incorrect vertices: %v != %v", tr.Vertices, expected)
+	}
+
+	for _, i := range tr.Indices {
+		if i < 0 || i >= len(tr.Vertices)/2 {
+			t.Errorf("index out of range: %v", i)
+		}
+	}
+}
+
+func TestPolygon_large(t *testing.T) {
+	// more than 80 vertices uses the z-order hash
+	shell := geo.Ring{}
+	hole := geo.Ring{}
+	for i := 0; i < 200; i++ {
+		a := 2 * math.Pi * float64(i) / 200
+		r := 10 + math.Sin(7*a)
+		shell = append(shell, geo.Point{r * math.Cos(a), r * math.Sin(a)})
+		hole = append(hole, geo.Point{3 * math.Cos(-a), 3 * math.Sin(-a)})
+	}
+
+	p := geo.Polygon{append(shell, shell[0]), append(hole, hole[0])}
+	tr := Polygon(p)
+	if tr.Len() != 400 {
+		t.Errorf("incorrect number of triangles: %v", tr.Len())
+	}
+
+	if d := tr.Deviation(p); d > 1e-12 {
+		t.Errorf("incorrect deviation: %v", d)
+	}
+}
+
+func TestMultiPolygon(t *testing.T) {
+	mp := geo.MultiPolygon{
+		{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		{{{2, 2}, {3, 2}, {3, 3}, {2, 3}, {2, 2}}},
+	}
+
+	tr := MultiPolygon(mp)
+	expected := []int{1, 2, 0, 5, 6, 3, 3, 4, 5}
+	if !reflect.DeepEqual(tr.Indices, expected) {
+		t.Errorf("incorrect indices: %v != %v", tr.Indices, expected)
+	}
+
+	if d := tr.Deviation(mp); d != 0 {
+		t.Errorf("incorrect deviation: %v", d)
+	}
+
+	if a := planar.Area(tr.MultiPolygon()); a != 1.5 {
+		t.Errorf("incorrect area: %v", a)
+	}
+}
+
+func TestGeometry_degenerate(t *testing.T) {
+	cases := []struct {
+		name string
+		geom geo.Geometry
+	}{
+		{
+			name: "collinear",
+			geom: geo.Polygon{{{0, 0}, {1, 0}, {2, 0}, {0, 0}}},
+		},
+		{
+			name: "too few points",
+			geom: geo.Polygon{{{0, 0}, {1, 0}, {0, 0}}},
+		},
+		{
+			name: "empty ring",
+			geom: geo.Polygon{{}},
+		},
+		{
+			name: "line string",
+			geom: geo.LineString{{0, 0}, {1, 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := Geometry(tc.geom)
+			if tr.Len() != 0 {
+				t.Errorf("should have no triangles: %v", tr.Indices)
+			}
+
+			if d := tr.Deviation(tc.geom); d != 0 {
+				t.Errorf("incorrect deviation: %v", d)
+			}
+		})
+	}
+}
+
+func TestGeometry_allGeometries(t *testing.T) {
+	for _, g := range geo.AllGeometries {
+		Geometry(g).Deviation(g)
+	}
+}
@@ -0,0 +1,130 @@
+package triangulate
+
+import (
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/clip"
+	"github.com/pchchv/geo/planar"
+)
+
+// Cell is the part of the plane closer to a point than to any other point.
+type Cell struct {
+	Site    int // the index of the point
+	Polygon geo.Polygon
+}
+
+// Voronoi returns the Voronoi diagram of the points clipped to the bound,
+// see Delaunay.Voronoi.
+func Voronoi(points geo.MultiPoint, b geo.Bound) []Cell {
+	return NewDelaunay(points).Voronoi(b)
+}
+
+// Voronoi returns the Voronoi cells of the points clipped to the bound,
+// sorted by site. Cells outside of the bound and the duplicate points have no cell.
+// The rings of the cells are counter-clockwise.
+func (d *Delaunay) Voronoi(b geo.Bound) []Cell {
+	n := len(d.Points)
+	onHull := make([]bool, n)
+	for _, i := range d.Hull {
+		onHull[i] = true
+	}
+
+	// an incoming halfedge for each point, for the points on the hull the one on the hull
+	inedges := make([]int, n)
+	for i := range inedges {
+		inedges[i] = -1
+	}
+
+	for e, o := range d.Halfedges {
+		p := d.Triangles[nextHalfedge(e)]
+		if o == -1 || inedges[p] == -1 {
+			inedges[p] = e
+		}
+	}
+
+	circumcenters := make([]geo.Point, d.Len())
+	for t := range circumcenters {
+		a, b, c := d.Points[d.Triangles[3*t]], d.Points[d.Triangles[3*t+1]], d.Points[d.Triangles[3*t+2]]
+		x, y := circumcenter(a[0], a[1], b[0], b[1], c[0], c[1])
+		circumcenters[t] = geo.Point{x, y}
+	}
+
+	neighbors := make([][]int, n)
+	for _, e := range d.Edges() {
+		neighbors[e[0]] = append(neighbors[e[0]], e[1])
+		neighbors[e[1]] = append(neighbors[e[1]], e[0])
+	}
+
+	var cells []Cell
+	for i := 0; i < n; i++ {
+		var r geo.Ring
+		switch {
+		case onHull[i]:
+			// the cell is unbounded, cut the bound
+			// by the bisectors with the neighbors
+			r = b.ToRing()
+			for _, j := range neighbors[i] {
+				if r = clipBisector(r, d.Points[i], d.Points[j]); r == nil {
+					break
+				}
+			}
+		case inedges[i] != -1:
+			// the circumcenters of the triangles around the point
+			e0 := inedges[i]
+			for e := e0; ; {
+				r = append(r, circumcenters[e/3])
+				e = nextHalfedge(e)
+				if e = d.Halfedges[e]; e == e0 || e == -1 {
+					break
+				}
+			}
+
+			r = append(r, r[0])
+			if p := clip.Polygon(b, geo.Polygon{r}); len(p) > 0 {
+				r = p[0]
+			} else {
+				r = nil
+			}
+		}
+
+		if len(r) < 4 || planar.Area(r) == 0 {
+			continue
+		}
+
+		if r.Orientation() != geo.CCW {
+			r.Reverse()
+		}
+
+		cells = append(cells, Cell{Site: i, Polygon: geo.Polygon{r}})
+	}
+
+	return cells
+}
+
+// clipBisector returns the part of the convex ring that is closer to p than to q.
+func clipBisector(r geo.Ring, p, q geo.Point) geo.Ring {
+	nx, ny := q[0]-p[0], q[1]-p[1]
+	mx, my := (p[0]+q[0])/2, (p[1]+q[1])/2
+	side := func(a geo.Point) float64 {
+		return (a[0]-mx)*nx + (a[1]-my)*ny
+	}
+
+	var out geo.Ring
+	for i := 0; i < len(r)-1; i++ {
+		a, b := r[i], r[i+1]
+		sa, sb := side(a), side(b)
+		if sa <= 0 {
+			out = append(out, a)
+		}
+
+		if (sa < 0 && sb > 0) || (sa > 0 && sb < 0) {
+			t := sa / (sa - sb)
+			out = append(out, geo.Point{a[0] + t*(b[0]-a[0]), a[1] + t*(b[1]-a[1])})
+		}
+	}
+
+	if len(out) < 3 {
+		return nil
+	}
+
+	return append(out, out[0])
+}
@@ -0,0 +1,135 @@
+package triangulate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pchchv/geo"
+	"github.com/pchchv/geo/planar"
+)
+
+func TestVoronoi(t *testing.T) {
+	points := geo.MultiPoint{}
+	for y := 1.0; y <= 3; y++ {
+		for x := 1.0; x <= 3; x++ {
+			points = append(points, geo.Point{x, y})
+		}
+	}
+
+	bound := geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{4, 4}}
+	cells := Voronoi(points, bound)
+	if len(cells) != 9 {
+		t.Fatalf("incorrect number of cells: %v", len(cells))
+	}
+
+	for i, c := range cells {
+		if c.Site != i {
+			t.Errorf("incorrect site: %v != %v", c.Site, i)
+		}
+
+		expected := c.Polygon.Bound()
+		if b := planar.Area(c.Polygon); math.Abs(b-planar.Area(expected)) > 1e-9 {
+			t.Errorf("cell %v should be a rectangle: %v", i, c.Polygon)
+		}
+
+		if !planar.PolygonContains(c.Polygon, points[c.Site]) {
+			t.Errorf("cell %v should contain its site: %v", i, c.Polygon)
+		}
+	}
+
+	center := geo.Bound{Min: geo.Point{1.5, 1.5}, Max: geo.Point{2.5, 2.5}}
+	if b := cells[4].Polygon.Bound(); !b.Equal(center) {
+		t.Errorf("incorrect center cell: %v", cells[4].Polygon)
+	}
+
+	if a := planar.Area(cells[0].Polygon); a != 2.25 {
+		t.Errorf("incorrect corner cell area: %v", a)
+	}
+}
+
+func TestVoronoi_random(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	points := make(geo.MultiPoint, 0, 200)
+	for i := 0; i < 200; i++ {
+		points = append(points, geo.Point{r.Float64() * 100, r.Float64() * 100})
+	}
+
+	bound := geo.Bound{Min: geo.Point{10, 10}, Max: geo.Point{90, 90}}
+	cells := Voronoi(points, bound)
+
+	area := 0.0
+	for _, c := range cells {
+		if c.Polygon[0].Orientation() != geo.CCW {
+			t.Fatalf("cell should be counter-clockwise: %v", c.Polygon)
+		}
+
+		area += planar.Area(c.Polygon)
+	}
+
+	if math.Abs(area-80*80) > 1e-6 {
+		t.Errorf("cells should cover the bound: %v", area)
+	}
+
+	// random points are in the cell of the nearest site
+	for i := 0; i < 100; i++ {
+		p := geo.Point{10 + r.Float64()*80, 10 + r.Float64()*80}
+		nearest := 0
+		for j := range points {
+			if planar.DistanceSquared(p, points[j]) < planar.DistanceSquared(p, points[nearest]) {
+				nearest = j
+			}
+		}
+
+		for _, c := range cells {
+			if c.Site == nearest && !planar.PolygonContains(c.Polygon, p) {
+				t.Errorf("cell of %v should contain %v", nearest, p)
+			}
+		}
+	}
+}
+
+func TestVoronoi_degenerate(t *testing.T) {
+	bound := geo.Bound{Min: geo.Point{0, 0}, Max: geo.Point{4, 4}}
+	cases := []struct {
+		name   string
+		points geo.MultiPoint
+		areas  []float64
+	}{
+		{
+			name:   "one point",
+			points: geo.MultiPoint{{1, 1}},
+			areas:  []float64{16},
+		},
+		{
+			name:   "collinear",
+			points: geo.MultiPoint{{1, 1}, {2, 2}, {3, 3}},
+			areas:  []float64{4.5, 7, 4.5},
+		},
+		{
+			name:   "duplicates",
+			points: geo.MultiPoint{{1, 2}, {3, 2}, {1, 2}},
+			areas:  []float64{8, 8},
+		},
+		{
+			name:   "outside the bound",
+			points: geo.MultiPoint{{1, 2}, {3, 2}, {20, 2}, {1, 3}},
+			areas:  []float64{5, 7.4375, 3.5625},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cells := Voronoi(tc.points, bound)
+			if len(cells) != len(tc.areas) {
+				t.Fatalf("incorrect number of cells: %v", cells)
+			}
+
+			for i, c := range cells {
+				if a := planar.Area(c.Polygon); math.Abs(a-tc.areas[i]) > 1e-9 {
+					t.Errorf("incorrect area of cell %v: %v != %v", c.Site, a, tc.areas[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package geo
+
+import "fmt"
+
+// Layout describes which ordinates, in addition to X and Y,
+// the points of a geometry have.
+type Layout int8
+
+const (
+	XY   Layout = iota // no extra ordinates
+	XYZ                // with elevation
+	XYM                // with measure
+	XYZM               // with elevation and measure
+)
+
+// HasZ returns true if the layout includes a Z ordinate.
+func (l Layout) HasZ() bool {
+	return l == XYZ || l == XYZM
+}
+
+// HasM returns true if the layout includes an M ordinate.
+func (l Layout) HasM() bool {
+	return l == XYM || l == XYZM
+}
+
+// String returns the layout in WKT notation, e.g. "Z" or "ZM".
+// XY is an empty string.
+func (l Layout) String() string {
+	switch l {
+	case XY:
+		return ""
+	case XYZ:
+		return "Z"
+	case XYM:
+		return "M"
+	case XYZM:
+		return "ZM"
+	}
+
+	return fmt.Sprintf("Layout(%d)", int8(l))
+}
+
+// ZM holds the Z and M values of the points of a geometry.
+// The values are stored in the order the points are visited,
+// i.e. ring after ring for polygons and member after member
+// for multi-geometries and collections. A bound is visited as the
+// 5 points of its polygon. Z is empty if the layout has no Z ordinate,
+// and M is empty if it has no M ordinate.
+type ZM struct {
+	Layout Layout
+	Z      []float64
+	M      []float64
+}
+
+// NewZM returns Z and M values, all zero, for the n points of a geometry.
+func NewZM(layout Layout, n int) ZM {
+	zm := ZM{Layout: layout}
+	if layout.HasZ() {
+		zm.Z = make([]float64, n)
+	}
+
+	if layout.HasM() {
+		zm.M = make([]float64, n)
+	}
+
+	return zm
+}
+
+// Matches returns true if there is a value for every point
+// of the geometry for each ordinate of the layout.
+func (zm ZM) Matches(g Geometry) bool {
+	n := PointCount(g)
+	if (zm.Layout.HasZ() && len(zm.Z) != n) || (!zm.Layout.HasZ() && len(zm.Z) != 0) {
+		return false
+	}
+
+	if (zm.Layout.HasM() && len(zm.M) != n) || (!zm.Layout.HasM() && len(zm.M) != 0) {
+		return false
+	}
+
+	return true
+}
+
+// Slice returns the values of the points from start up to, but not including, end.
+// The result shares the underlying arrays with zm.
+func (zm ZM) Slice(start, end int) ZM {
+	s := ZM{Layout: zm.Layout}
+	if zm.Layout.HasZ() {
+		s.Z = zm.Z[start:end]
+	}
+
+	if zm.Layout.HasM() {
+		s.M = zm.M[start:end]
+	}
+
+	return s
+}
+
+// Clone returns a deep copy of the values.
+func (zm ZM) Clone() ZM {
+	c := ZM{Layout: zm.Layout}
+	if zm.Z != nil {
+		c.Z = append([]float64(nil), zm.Z...)
+	}
+
+	if zm.M != nil {
+		c.M = append([]float64(nil), zm.M...)
+	}
+
+	return c
+}
+
+// PointCount returns the number of points in the geometry,
+// counted in the order used by ZM.
+func PointCount(g Geometry) int {
+	switch g := g.(type) {
+	case nil:
+		return 0
+	case Point:
+		return 1
+	case MultiPoint:
+		return len(g)
+	case LineString:
+		return len(g)
+	case MultiLineString:
+		n := 0
+		for _, ls := range g {
+			n += len(ls)
+		}
+
+		return n
+	case Ring:
+		return len(g)
+	case Polygon:
+		n := 0
+		for _, r := range g {
+			n += len(r)
+		}
+
+		return n
+	case MultiPolygon:
+		n := 0
+		for _, p := range g {
+			n += PointCount(p)
+		}
+
+		return n
+	case Collection:
+		n := 0
+		for _, c := range g {
+			n += PointCount(c)
+		}
+
+		return n
+	case Bound:
+		return 5
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
@@ -0,0 +1,105 @@
+package geo
+
+import "testing"
+
+func TestLayout(t *testing.T) {
+	cases := []struct {
+		layout Layout
+		z, m   bool
+		name   string
+	}{
+		{layout: XY, name: ""},
+		{layout: XYZ, z: true, name: "Z"},
+		{layout: XYM, m: true, name: "M"},
+		{layout: XYZM, z: true, m: true, name: "ZM"},
+	}
+
+	for _, tc := range cases {
+		if v := tc.layout.HasZ(); v != tc.z {
+			t.Errorf("%v: incorrect has z: %v != %v", tc.layout, v, tc.z)
+		}
+
+		if v := tc.layout.HasM(); v != tc.m {
+			t.Errorf("%v: incorrect has m: %v != %v", tc.layout, v, tc.m)
+		}
+
+		if v := tc.layout.String(); v != tc.name {
+			t.Errorf("incorrect string: %v != %v", v, tc.name)
+		}
+	}
+}
+
+func TestZM_Matches(t *testing.T) {
+	ls := LineString{{0, 0}, {1, 1}, {2, 2}}
+	cases := []struct {
+		name   string
+		zm     ZM
+		result bool
+	}{
+		{
+			name:   "no values",
+			zm:     ZM{},
+			result: true,
+		},
+		{
+			name:   "z values",
+			zm:     NewZM(XYZ, 3),
+			result: true,
+		},
+		{
+			name:   "too few z values",
+			zm:     NewZM(XYZM, 2),
+			result: false,
+		},
+		{
+			name:   "m values not in layout",
+			zm:     ZM{Layout: XYZ, Z: []float64{1, 2, 3}, M: []float64{1, 2, 3}},
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.zm.Matches(ls); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestPointCount(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   Geometry
+		result int
+	}{
+		{
+			name:   "polygon",
+			geom:   Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}, {{0, 0}, {1, 0}, {0, 0}}},
+			result: 7,
+		},
+		{
+			name:   "bound",
+			geom:   Bound{Max: Point{1, 1}},
+			result: 5,
+		},
+		{
+			name:   "collection",
+			geom:   Collection{Point{}, LineString{{0, 0}, {1, 1}}, Collection{MultiPoint{{}, {}}}},
+			result: 5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := PointCount(tc.geom); v != tc.result {
+				t.Errorf("incorrect count: %v != %v", v, tc.result)
+			}
+		})
+	}
+
+	for _, g := range AllGeometries {
+		// should not panic with unsupported type
+		PointCount(g)
+	}
+}